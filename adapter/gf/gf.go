@@ -7,6 +7,18 @@ import (
 	"github.com/LandcLi/landc-logface/internal/logger"
 )
 
+// NoticeLevel glog的Notice级别，注册为介于Info和Warn之间的自定义级别 "NOTICE"
+// 如果应用已经注册了NOTICE级别，则沿用已有的注册
+var NoticeLevel = noticeLevel()
+
+// noticeLevel 查找或注册NOTICE级别
+func noticeLevel() logger.LogLevel {
+	if level, err := logger.ParseLevel("NOTICE"); err == nil {
+		return level
+	}
+	return logger.MustRegisterLevel("NOTICE", logger.InfoLevel.Severity()+5)
+}
+
 // GFLogger 是goframe框架的日志适配器
 type GFLogger struct {
 	log logger.Logger
@@ -66,17 +78,17 @@ func (g *GFLogger) Infoln(ctx context.Context, v ...interface{}) {
 
 // Notice 实现glog.ILogger接口的Notice方法
 func (g *GFLogger) Notice(ctx context.Context, v ...interface{}) {
-	g.log.Info(fmt.Sprint(v...))
+	g.log.Log(NoticeLevel, fmt.Sprint(v...))
 }
 
 // Noticef 实现glog.ILogger接口的Noticef方法
 func (g *GFLogger) Noticef(ctx context.Context, format string, v ...interface{}) {
-	g.log.Infof(format, v...)
+	g.log.Logf(NoticeLevel, format, v...)
 }
 
 // Noticeln 实现glog.ILogger接口的Noticeln方法
 func (g *GFLogger) Noticeln(ctx context.Context, v ...interface{}) {
-	g.log.Info(fmt.Sprintln(v...))
+	g.log.Log(NoticeLevel, fmt.Sprintln(v...))
 }
 
 // Warning 实现glog.ILogger接口的Warning方法
@@ -147,8 +159,10 @@ func (g *GFLogger) SetLevel(level int) {
 	switch level {
 	case 0: // glog.LEVEL_DEBUG
 		g.log.SetLevel(logger.DebugLevel)
-	case 1, 2: // glog.LEVEL_INFO, glog.LEVEL_NOTICE
+	case 1: // glog.LEVEL_INFO
 		g.log.SetLevel(logger.InfoLevel)
+	case 2: // glog.LEVEL_NOTICE
+		g.log.SetLevel(NoticeLevel)
 	case 3: // glog.LEVEL_WARNING
 		g.log.SetLevel(logger.WarnLevel)
 	case 4: // glog.LEVEL_ERROR
@@ -202,7 +216,7 @@ func (g *GFLogger) IsInfo() bool {
 
 // IsNotice 实现glog.ILogger接口的IsNotice方法
 func (g *GFLogger) IsNotice() bool {
-	return g.log.IsInfoEnabled()
+	return g.log.IsLevelEnabled(NoticeLevel)
 }
 
 // IsWarning 实现glog.ILogger接口的IsWarning方法
@@ -22,11 +22,21 @@ func NewGinLogger(log logger.Logger) *GinLogger {
 }
 
 // Logger 返回gin的日志中间件
+// 请求开始时创建带有trace_id、method和uri字段的请求日志实例，保存到请求的上下文中，
+// 处理函数和服务层可以通过RequestLogger或lclogface.FromContext(c.Request.Context())取出
 func (g *GinLogger) Logger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 开始时间
 		startTime := time.Now()
 
+		// 请求日志实例
+		reqLogger := g.log.WithFields(
+			logger.String("trace_id", traceID(c)),
+			logger.String("method", c.Request.Method),
+			logger.String("uri", c.Request.RequestURI),
+		)
+		c.Request = c.Request.WithContext(logger.NewContext(c.Request.Context(), reqLogger))
+
 		// 处理请求
 		c.Next()
 
@@ -36,64 +46,71 @@ func (g *GinLogger) Logger() gin.HandlerFunc {
 		// 执行时间
 		latencyTime := endTime.Sub(startTime)
 
-		// 请求方式
-		reqMethod := c.Request.Method
-
-		// 请求路由
-		reqUri := c.Request.RequestURI
-
 		// 状态码
 		statusCode := c.Writer.Status()
 
-		// 请求IP
-		clientIP := c.ClientIP()
-		traceID := c.Request.Header.Get("X-Trace-ID")
-		if traceID == "" {
-			var ok bool
-			traceID,ok = c.Request.Context().Value("trace_id").(string)
-			if !ok{
-				traceID = fmt.Sprintf("%d", time.Now().UnixNano())
-			}
-		}
-
 		// 日志字段
 		fields := []logger.Field{
-			{Key: "status", Value: statusCode},
-			{Key: "method", Value: reqMethod},
-			{Key: "uri", Value: reqUri},
-			{Key: "ip", Value: clientIP},
-			{Key: "latency", Value: latencyTime},
-			{Key: "timestamp", Value: endTime},
-			{Key: "trace_id", Value: traceID},
+			logger.Int("status", statusCode),
+			logger.String("ip", c.ClientIP()),
+			logger.Duration("latency", latencyTime),
+			logger.Time("timestamp", endTime),
 			{Key: "error", Value: c.Errors},
 		}
 
 		// 根据状态码设置日志级别
 		switch {
 		case statusCode >= 500:
-			g.log.Error("", fields...)
+			reqLogger.Error("", fields...)
 		case statusCode >= 400:
-			g.log.Warn("", fields...)
+			reqLogger.Warn("", fields...)
 		case statusCode >= 300:
-			g.log.Info("", fields...)
+			reqLogger.Info("", fields...)
 		default:
-			g.log.Info("", fields...)
+			reqLogger.Info("", fields...)
 		}
 	}
 }
 
+// traceID 获取请求的追踪ID，依次使用X-Trace-ID请求头、上下文中的追踪ID，都没有时生成一个
+func traceID(c *gin.Context) string {
+	if traceID := c.Request.Header.Get("X-Trace-ID"); traceID != "" {
+		return traceID
+	}
+	ctx := c.Request.Context()
+	if traceID := logger.TraceIDFromContext(ctx); traceID != "" {
+		return traceID
+	}
+	if traceID, ok := ctx.Value("trace_id").(string); ok && traceID != "" {
+		return traceID
+	}
+	return fmt.Sprintf("%d", time.Now().UnixNano())
+}
+
+// RequestLogger 返回Logger中间件为当前请求创建的日志实例，没有使用该中间件时返回全局日志实例
+func RequestLogger(c *gin.Context) logger.Logger {
+	return logger.FromContext(c.Request.Context())
+}
+
 // Recovery 返回gin的恢复中间件，使用我们的日志门面记录错误
 func (g *GinLogger) Recovery() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				// 记录错误日志
-				g.log.Error("",
-					logger.Field{Key: "method", Value: c.Request.Method},
-					logger.Field{Key: "uri", Value: c.Request.RequestURI},
-					logger.Field{Key: "ip", Value: c.ClientIP()},
-					logger.Field{Key: "error", Value: err},
-				)
+				// 记录错误日志，优先使用带有请求字段的日志实例
+				if reqLogger, ok := logger.LoggerFromContext(c.Request.Context()); ok {
+					reqLogger.Error("",
+						logger.String("ip", c.ClientIP()),
+						logger.Field{Key: "error", Value: err},
+					)
+				} else {
+					g.log.Error("",
+						logger.Field{Key: "method", Value: c.Request.Method},
+						logger.Field{Key: "uri", Value: c.Request.RequestURI},
+						logger.Field{Key: "ip", Value: c.ClientIP()},
+						logger.Field{Key: "error", Value: err},
+					)
+				}
 
 				// 响应500错误
 				c.AbortWithStatus(500)
@@ -192,6 +192,9 @@ func ExampleWithFields() {
 	// 继续添加字段
 	userLogger.WithField("status", "success").Info("注册成功")
 
+	// 使用交替出现的键和值
+	userLogger.Infow("用户登录", "username", "john", "attempts", 1)
+
 	fmt.Println("字段使用示例完成")
 }
 
@@ -209,6 +212,7 @@ func ExampleGlobalFunctions() {
 
 	// 使用全局函数带字段
 	lclogface.Info("全局带字段日志", lclogface.Field{Key: "key", Value: "value"})
+	lclogface.Infow("全局键值对日志", "key", "value")
 
 	fmt.Println("全局函数使用示例完成")
 }
@@ -269,6 +273,21 @@ func (c *CustomLogger) GetLevel() lclogface.LogLevel {
 	return lclogface.InfoLevel
 }
 
+// Trace 输出跟踪级日志
+func (c *CustomLogger) Trace(msg string, fields ...lclogface.Field) {
+	fmt.Printf("[CUSTOM] [TRACE] [%s] %s\n", c.name, msg)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (c *CustomLogger) Tracef(format string, args ...interface{}) {
+	fmt.Printf("[CUSTOM] [TRACE] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	c.Trace(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Debug 输出调试级日志
 func (c *CustomLogger) Debug(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [DEBUG] [%s] %s\n", c.name, msg)
@@ -279,6 +298,11 @@ func (c *CustomLogger) Debugf(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [DEBUG] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	c.Debug(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Info 输出信息级日志
 func (c *CustomLogger) Info(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [INFO] [%s] %s\n", c.name, msg)
@@ -289,6 +313,11 @@ func (c *CustomLogger) Infof(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [INFO] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Infow(msg string, keysAndValues ...interface{}) {
+	c.Info(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Warn 输出警告级日志
 func (c *CustomLogger) Warn(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [WARN] [%s] %s\n", c.name, msg)
@@ -299,6 +328,11 @@ func (c *CustomLogger) Warnf(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [WARN] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	c.Warn(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Error 输出错误级日志
 func (c *CustomLogger) Error(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [ERROR] [%s] %s\n", c.name, msg)
@@ -309,6 +343,11 @@ func (c *CustomLogger) Errorf(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [ERROR] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	c.Error(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Fatal 输出致命级日志并退出程序
 func (c *CustomLogger) Fatal(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [FATAL] [%s] %s\n", c.name, msg)
@@ -319,6 +358,11 @@ func (c *CustomLogger) Fatalf(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [FATAL] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	c.Fatal(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
 // Panic 输出恐慌级日志并触发panic
 func (c *CustomLogger) Panic(msg string, fields ...lclogface.Field) {
 	fmt.Printf("[CUSTOM] [PANIC] [%s] %s\n", c.name, msg)
@@ -329,11 +373,66 @@ func (c *CustomLogger) Panicf(format string, args ...interface{}) {
 	fmt.Printf("[CUSTOM] [PANIC] [%s] "+format+"\n", append([]interface{}{c.name}, args...)...)
 }
 
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	c.Panic(msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
+// Log 按指定级别输出日志
+func (c *CustomLogger) Log(level lclogface.LogLevel, msg string, fields ...lclogface.Field) {
+	fmt.Printf("[CUSTOM] [%s] [%s] %s\n", level, c.name, msg)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (c *CustomLogger) Logf(level lclogface.LogLevel, format string, args ...interface{}) {
+	fmt.Printf("[CUSTOM] [%s] [%s] "+format+"\n", append([]interface{}{level, c.name}, args...)...)
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Logw(level lclogface.LogLevel, msg string, keysAndValues ...interface{}) {
+	c.Log(level, msg, lclogface.KeyValueFields(keysAndValues)...)
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) TraceContext(ctx context.Context, msg string, fields ...lclogface.Field) {
+	c.Trace(msg, fields...)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) DebugContext(ctx context.Context, msg string, fields ...lclogface.Field) {
+	c.Debug(msg, fields...)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) InfoContext(ctx context.Context, msg string, fields ...lclogface.Field) {
+	c.Info(msg, fields...)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) WarnContext(ctx context.Context, msg string, fields ...lclogface.Field) {
+	c.Warn(msg, fields...)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) ErrorContext(ctx context.Context, msg string, fields ...lclogface.Field) {
+	c.Error(msg, fields...)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (c *CustomLogger) LogContext(ctx context.Context, level lclogface.LogLevel, msg string, fields ...lclogface.Field) {
+	c.Log(level, msg, fields...)
+}
+
 // WithFields 添加字段到日志
 func (c *CustomLogger) WithFields(fields ...lclogface.Field) lclogface.Logger {
 	return c
 }
 
+// Named 创建子日志实例
+func (c *CustomLogger) Named(name string) lclogface.Logger {
+	return NewCustomLogger(c.name + "." + name)
+}
+
 // WithField 添加单个字段到日志
 func (c *CustomLogger) WithField(key string, value interface{}) lclogface.Logger {
 	return c
@@ -354,6 +453,16 @@ func (c *CustomLogger) WithTime(t time.Time) lclogface.Logger {
 	return c
 }
 
+// WithStack 为每条日志附加调用堆栈
+func (c *CustomLogger) WithStack() lclogface.Logger {
+	return c
+}
+
+// IsTraceEnabled 检查跟踪级别是否启用
+func (c *CustomLogger) IsTraceEnabled() bool {
+	return true
+}
+
 // IsDebugEnabled 检查调试级别是否启用
 func (c *CustomLogger) IsDebugEnabled() bool {
 	return true
@@ -384,11 +493,21 @@ func (c *CustomLogger) IsPanicEnabled() bool {
 	return true
 }
 
+// IsLevelEnabled 检查指定级别是否启用
+func (c *CustomLogger) IsLevelEnabled(level lclogface.LogLevel) bool {
+	return true
+}
+
 // Sync 刷新日志缓冲区
 func (c *CustomLogger) Sync() error {
 	return nil
 }
 
+// Close 关闭日志输出
+func (c *CustomLogger) Close() error {
+	return nil
+}
+
 // CustomLoggerProvider 自定义日志提供者
 type CustomLoggerProvider struct{}
 
@@ -0,0 +1,222 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy 异步写入队列已满时的处理方式
+type OverflowPolicy string
+
+const (
+	// OverflowBlock 等待队列有空位，不丢弃日志
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDropNewest 丢弃正在写入的日志
+	OverflowDropNewest OverflowPolicy = "drop_newest"
+	// OverflowDropOldest 丢弃队列中最早的日志，为正在写入的日志腾出空位
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowDropBelowLevel 丢弃低于OverflowLevel的日志，不低于该级别的日志等待队列有空位
+	OverflowDropBelowLevel OverflowPolicy = "drop_below_level"
+)
+
+// valid 判断是否为支持的处理方式，空值表示OverflowBlock
+func (p OverflowPolicy) valid() bool {
+	switch p {
+	case "", OverflowBlock, OverflowDropNewest, OverflowDropOldest, OverflowDropBelowLevel:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowPolicy 解析队列已满时的处理方式，不区分大小写，空字符串表示OverflowBlock
+func ParseOverflowPolicy(text string) (OverflowPolicy, error) {
+	policy := OverflowPolicy(strings.ToLower(text))
+	if !policy.valid() {
+		return "", fmt.Errorf("unknown overflow policy %q", text)
+	}
+	if policy == "" {
+		policy = OverflowBlock
+	}
+	return policy, nil
+}
+
+// maxAsyncBatch 异步写入时一次批量写入的最大字节数，超过单条日志大小时至少写入一条
+const maxAsyncBatch = 64 * 1024
+
+// droppedEntries 所有异步输出丢弃的日志条数
+var droppedEntries atomic.Uint64
+
+// DroppedEntries 返回进程内所有异步输出因队列已满丢弃的日志条数
+func DroppedEntries() uint64 {
+	return droppedEntries.Load()
+}
+
+// asyncWriter 通过有界队列在后台协程中批量写入的输出
+type asyncWriter struct {
+	writer   io.Writer
+	policy   OverflowPolicy
+	level    LogLevel // OverflowDropBelowLevel时不会被丢弃的最低级别
+	dropped  atomic.Uint64
+	mu       sync.Mutex
+	notEmpty *sync.Cond // 队列中有待写入的日志或已关闭
+	notFull  *sync.Cond // 队列有空位或已关闭
+	idle     *sync.Cond // 队列为空且后台协程没有在写入
+	queue    [][]byte   // 环形队列
+	head     int
+	count    int
+	writing  bool
+	closed   bool
+	done     chan struct{}
+}
+
+// newAsyncWriter 创建写入writer的异步输出，队列容量为options.AsyncQueueSize条
+func newAsyncWriter(writer io.Writer, options *LoggerOptions) *asyncWriter {
+	w := &asyncWriter{
+		writer: writer,
+		policy: options.OverflowPolicy,
+		level:  options.OverflowLevel,
+		queue:  make([][]byte, options.AsyncQueueSize),
+		done:   make(chan struct{}),
+	}
+	w.notEmpty = sync.NewCond(&w.mu)
+	w.notFull = sync.NewCond(&w.mu)
+	w.idle = sync.NewCond(&w.mu)
+	go w.run()
+	return w
+}
+
+// belowLevelWriter OverflowDropBelowLevel时写入低于OverflowLevel的日志使用的输出
+// 与asyncWriter写入同一个队列，队列已满时直接丢弃日志，不会等待
+type belowLevelWriter struct {
+	async *asyncWriter
+}
+
+// Write 实现io.Writer
+func (w belowLevelWriter) Write(p []byte) (int, error) {
+	return w.async.write(p, true)
+}
+
+// drop 记录一条被丢弃的日志
+func (w *asyncWriter) drop() {
+	w.dropped.Add(1)
+	droppedEntries.Add(1)
+}
+
+// Write 实现io.Writer，将日志复制到队列中后立即返回，关闭后直接写入
+func (w *asyncWriter) Write(p []byte) (int, error) {
+	return w.write(p, false)
+}
+
+// write 将日志复制到队列中，队列已满时按处理方式等待或丢弃
+// below表示日志低于OverflowLevel，是否丢弃与入队在同一次加锁中决定，因此这类日志不会等待队列有空位
+func (w *asyncWriter) write(p []byte, below bool) (int, error) {
+	entry := append([]byte(nil), p...) // 调用方会复用p
+	w.mu.Lock()
+	for w.count == len(w.queue) && !w.closed {
+		switch {
+		case below, w.policy == OverflowDropNewest:
+			w.mu.Unlock()
+			w.drop()
+			return len(p), nil
+		case w.policy == OverflowDropOldest:
+			w.queue[w.head] = nil
+			w.head = (w.head + 1) % len(w.queue)
+			w.count--
+			w.drop()
+		default:
+			w.notFull.Wait()
+		}
+	}
+	if w.closed {
+		w.mu.Unlock()
+		return w.writer.Write(p)
+	}
+	w.queue[(w.head+w.count)%len(w.queue)] = entry
+	w.count++
+	w.notEmpty.Signal()
+	w.mu.Unlock()
+	return len(p), nil
+}
+
+// run 在后台协程中批量写入队列中的日志，关闭后写完剩余的日志再退出
+func (w *asyncWriter) run() {
+	defer close(w.done)
+	var batch []byte
+	for {
+		w.mu.Lock()
+		for w.count == 0 && !w.closed {
+			w.notEmpty.Wait()
+		}
+		if w.count == 0 {
+			w.mu.Unlock()
+			return
+		}
+		batch = batch[:0]
+		for w.count > 0 && (len(batch) == 0 || len(batch)+len(w.queue[w.head]) <= maxAsyncBatch) {
+			batch = append(batch, w.queue[w.head]...)
+			w.queue[w.head] = nil
+			w.head = (w.head + 1) % len(w.queue)
+			w.count--
+		}
+		w.writing = true
+		w.notFull.Broadcast()
+		w.mu.Unlock()
+
+		if _, err := w.writer.Write(batch); err != nil {
+			ReportError(fmt.Errorf("async log write: %w", err))
+		}
+
+		w.mu.Lock()
+		w.writing = false
+		if w.count == 0 {
+			w.idle.Broadcast()
+		}
+		w.mu.Unlock()
+	}
+}
+
+// Sync 等待队列中的日志全部写入，底层输出支持Sync时再调用它，标准输出和标准错误除外
+func (w *asyncWriter) Sync() error {
+	w.mu.Lock()
+	for w.count > 0 || w.writing {
+		w.idle.Wait()
+	}
+	w.mu.Unlock()
+	return syncWriter(w.writer)
+}
+
+// Dropped 返回该输出因队列已满丢弃的日志条数
+func (w *asyncWriter) Dropped() uint64 {
+	return w.dropped.Load()
+}
+
+// Close 实现io.Closer，写完队列中的日志后关闭底层输出，多次调用只关闭一次
+func (w *asyncWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.notEmpty.Broadcast()
+	w.notFull.Broadcast()
+	w.mu.Unlock()
+
+	<-w.done
+	return CloseOutputWriter(w.writer)
+}
+
+// syncWriter 刷新支持Sync的输出，标准输出和标准错误可能是不支持Sync的终端或管道，因此跳过
+func syncWriter(w io.Writer) error {
+	if w == os.Stdout || w == os.Stderr {
+		return nil
+	}
+	if syncer, ok := w.(interface{ Sync() error }); ok {
+		return syncer.Sync()
+	}
+	return nil
+}
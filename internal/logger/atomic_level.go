@@ -0,0 +1,57 @@
+package logger
+
+import "sync/atomic"
+
+// AtomicLevel 可在任意goroutine中安全修改的日志级别
+// 日志实例与通过WithField、WithContext等派生出的子实例共享同一个AtomicLevel，修改立即对它们全部生效
+type AtomicLevel struct {
+	level atomic.Int32
+}
+
+// NewAtomicLevel 创建指定初始级别的AtomicLevel
+func NewAtomicLevel(level LogLevel) *AtomicLevel {
+	a := &AtomicLevel{}
+	a.SetLevel(level)
+	return a
+}
+
+// Level 获取当前日志级别
+func (a *AtomicLevel) Level() LogLevel {
+	return LogLevel(a.level.Load())
+}
+
+// SetLevel 设置日志级别
+func (a *AtomicLevel) SetLevel(level LogLevel) {
+	a.level.Store(int32(level))
+}
+
+// Enabled 判断指定级别的日志是否会被输出
+func (a *AtomicLevel) Enabled(level LogLevel) bool {
+	return level.Severity() >= a.Level().Severity()
+}
+
+// String 返回当前日志级别的名称
+func (a *AtomicLevel) String() string {
+	return a.Level().String()
+}
+
+// AtomicLeveler 可以返回自身AtomicLevel的日志实例，内置的日志实例都实现了该接口
+type AtomicLeveler interface {
+	AtomicLevel() *AtomicLevel
+}
+
+// WithAtomicLevel 使用给定的AtomicLevel作为日志级别，多个日志实例可以共享同一个级别
+// 创建时使用该AtomicLevel当前的级别，忽略WithLevel
+func WithAtomicLevel(level *AtomicLevel) Option {
+	return func(opt *LoggerOptions) {
+		opt.AtomicLevel = level
+	}
+}
+
+// GetAtomicLevel 返回选项中的AtomicLevel，没有时按Level创建新的AtomicLevel
+func (o *LoggerOptions) GetAtomicLevel() *AtomicLevel {
+	if o.AtomicLevel != nil {
+		return o.AtomicLevel
+	}
+	return NewAtomicLevel(o.Level)
+}
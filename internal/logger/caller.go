@@ -0,0 +1,108 @@
+package logger
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// facadePrefixes 日志门面自身的包以及slog，查找调用位置时跳过这些包中的栈帧
+var facadePrefixes []string
+
+// init 根据当前包的路径计算门面各个包的函数名前缀，不依赖模块路径的写法
+func init() {
+	pc, _, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name() // 如 github.com/LandcLi/landc-logface/internal/logger.init.0
+	module := name[:strings.Index(name, "/internal/logger.")]
+	facadePrefixes = []string{
+		module + "/internal/logger.",
+		module + "/lclogface.",
+		module + "/providers/",
+		module + "/adapter/",
+		"log/slog.", // 通过NewSlogHandler输出时，调用位置是调用slog的代码
+	}
+}
+
+// isFacadeFrame 判断函数是否属于日志门面自身
+func isFacadeFrame(function string) bool {
+	for _, prefix := range facadePrefixes {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CallerFrame 返回调用日志门面的代码所在的栈帧，供日志提供者输出调用位置
+// 门面内部的栈帧（提供者、全局函数等）总是被跳过，skip为在此基础上继续向上跳过的层数，
+// 用于应用自己封装了日志函数的情况
+func CallerFrame(skip int) (runtime.Frame, bool) {
+	var buf [64]uintptr
+	pcs := buf[:]
+	if skip > len(buf)/2 {
+		pcs = make([]uintptr, len(buf)+skip)
+	}
+	n := runtime.Callers(2, pcs)
+
+	var caller runtime.Frame
+	found := false
+	appFrames(pcs[:n], skip, func(frame runtime.Frame) bool {
+		caller, found = frame, true
+		return false
+	})
+	return caller, found
+}
+
+// appFrames 依次访问pcs中应用代码的栈帧，直到visit返回false
+// 开头属于门面自身的栈帧和之后的skip层不会被访问
+func appFrames(pcs []uintptr, skip int, visit func(frame runtime.Frame) bool) {
+	frames := runtime.CallersFrames(pcs)
+	outside := false
+	for {
+		frame, more := frames.Next()
+		if outside || !isFacadeFrame(frame.Function) {
+			outside = true
+			if skip > 0 {
+				skip--
+			} else if !visit(frame) {
+				return
+			}
+		}
+		if !more {
+			return
+		}
+	}
+}
+
+// FormatCaller 以 "目录/文件:行号" 的形式返回调用位置
+func FormatCaller(frame runtime.Frame) string {
+	file := frame.File
+	if idx := strings.LastIndexByte(file, '/'); idx >= 0 {
+		if idx = strings.LastIndexByte(file[:idx], '/'); idx >= 0 {
+			file = file[idx+1:]
+		}
+	}
+	return file + ":" + strconv.Itoa(frame.Line)
+}
+
+// ShortFunction 去掉函数名中的包路径，如 "github.com/a/b/pkg.(*T).Run" 返回 "pkg.(*T).Run"
+func ShortFunction(function string) string {
+	if idx := strings.LastIndexByte(function, '/'); idx >= 0 {
+		return function[idx+1:]
+	}
+	return function
+}
+
+// WithCaller 设置是否输出调用位置（文件、行号和函数名）
+func WithCaller(enabled bool) Option {
+	return func(opt *LoggerOptions) {
+		opt.Caller = enabled
+	}
+}
+
+// WithCallerSkip 设置输出调用位置时额外跳过的调用层数，应用封装了自己的日志函数时使用
+func WithCallerSkip(skip int) Option {
+	return func(opt *LoggerOptions) {
+		opt.CallerSkip = skip
+	}
+}
@@ -1,44 +1,86 @@
 package logger
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 )
 
 // LogConfig 统一的日志配置类
 type LogConfig struct {
 	// 基础配置
-	Provider     string        `json:"provider" yaml:"provider"`     // 日志提供者名称
-	Name         string        `json:"name" yaml:"name"`             // 日志名称
-	Level        LogLevel      `json:"level" yaml:"level"`           // 日志级别
-	Format       string        `json:"format" yaml:"format"`         // 日志格式（text/json）
-	OutputPath   string        `json:"outputPath" yaml:"outputPath"` // 日志输出路径
+	Provider   string   `json:"provider" yaml:"provider"`     // 日志提供者名称
+	Name       string   `json:"name" yaml:"name"`             // 日志名称
+	Level      LogLevel `json:"level" yaml:"level"`           // 日志级别
+	Format     string   `json:"format" yaml:"format"`         // 日志格式（text/json）
+	OutputPath string   `json:"outputPath" yaml:"outputPath"` // 日志输出路径
+
+	// 多输出配置，设置后OutputPath不再生效
+	Outputs []OutputConfig `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 
 	// 日志文件轮转配置
-	MaxLogSize    int64         `json:"maxLogSize" yaml:"maxLogSize"`       // 单个日志文件最大大小（MB）
-	MaxLogAge     time.Duration `json:"maxLogAge" yaml:"maxLogAge"`         // 日志文件最大保留时间
-	MaxLogFiles   int           `json:"maxLogFiles" yaml:"maxLogFiles"`     // 最大保留日志文件数量
-	CompressLogs  bool          `json:"compressLogs" yaml:"compressLogs"`   // 是否压缩旧日志
-	MaxMessageSize int          `json:"maxMessageSize" yaml:"maxMessageSize"` // 单条日志最大大小（KB）
+	MaxLogSize     int64         `json:"maxLogSize" yaml:"maxLogSize"`         // 单个日志文件最大大小（MB）
+	MaxLogAge      time.Duration `json:"maxLogAge" yaml:"maxLogAge"`           // 日志文件最大保留时间
+	MaxLogFiles    int           `json:"maxLogFiles" yaml:"maxLogFiles"`       // 最大保留日志文件数量
+	CompressLogs   bool          `json:"compressLogs" yaml:"compressLogs"`     // 是否压缩旧日志
+	MaxMessageSize int           `json:"maxMessageSize" yaml:"maxMessageSize"` // 单条日志最大大小（KB）
+
+	// 按时间轮转配置，输出路径可以包含时间模式，如"logs/app-%Y%m%d-%H.log"
+	RotateInterval time.Duration `json:"rotateInterval,omitempty" yaml:"rotateInterval,omitempty"` // 按时间轮转的间隔，0表示只按大小轮转
+	CurrentLink    string        `json:"currentLink,omitempty" yaml:"currentLink,omitempty"`       // 指向当前日志文件的符号链接路径
+
+	// 异步写入配置，所有输出都使用同样的配置
+	AsyncQueueSize int            `json:"asyncQueueSize,omitempty" yaml:"asyncQueueSize,omitempty"` // 异步写入队列的容量（条），0表示同步写入
+	OverflowPolicy OverflowPolicy `json:"overflowPolicy,omitempty" yaml:"overflowPolicy,omitempty"` // 异步写入队列已满时的处理方式
+	OverflowLevel  *LogLevel      `json:"overflowLevel,omitempty" yaml:"overflowLevel,omitempty"`   // drop_below_level时不会被丢弃的最低级别，为nil时为WarnLevel
+
+	// 调用位置配置
+	Caller     bool `json:"caller" yaml:"caller"`         // 是否输出调用位置
+	CallerSkip int  `json:"callerSkip" yaml:"callerSkip"` // 输出调用位置时额外跳过的调用层数
+
+	// 堆栈配置
+	StackLevel *LogLevel `json:"stackLevel,omitempty" yaml:"stackLevel,omitempty"` // 附加堆栈的最低级别，为nil时不附加
 
 	// 额外配置
-	ExtraConfig   map[string]interface{} `json:"extraConfig" yaml:"extraConfig"` // 额外的提供者特定配置
+	ExtraConfig map[string]interface{} `json:"extraConfig" yaml:"extraConfig"` // 额外的提供者特定配置
 }
 
 // NewLogConfig 创建默认的日志配置
 func NewLogConfig() *LogConfig {
 	return &LogConfig{
-		Provider:     "console",
-		Name:         "app",
-		Level:        InfoLevel,
-		Format:       "text",
-		OutputPath:   "stdout",
-		MaxLogSize:   100, // 默认100MB
-		MaxLogAge:    7 * 24 * time.Hour, // 默认7天
-		MaxLogFiles:  10, // 默认10个文件
-		CompressLogs: false, // 默认不压缩
-		MaxMessageSize: 0, // 默认不限制
-		ExtraConfig:  make(map[string]interface{}),
+		Provider:       "console",
+		Name:           "app",
+		Level:          InfoLevel,
+		Format:         "text",
+		OutputPath:     "stdout",
+		MaxLogSize:     100,                // 默认100MB
+		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
+		MaxLogFiles:    10,                 // 默认10个文件
+		CompressLogs:   false,              // 默认不压缩
+		MaxMessageSize: 0,                  // 默认不限制
+		ExtraConfig:    make(map[string]interface{}),
+	}
+}
+
+// Clone 复制一份配置，Outputs会被深拷贝，ExtraConfig会被浅拷贝
+func (c *LogConfig) Clone() *LogConfig {
+	clone := *c
+	if c.StackLevel != nil {
+		level := *c.StackLevel
+		clone.StackLevel = &level
+	}
+	if c.OverflowLevel != nil {
+		level := *c.OverflowLevel
+		clone.OverflowLevel = &level
 	}
+	clone.Outputs = cloneOutputs(c.Outputs)
+	clone.ExtraConfig = make(map[string]interface{}, len(c.ExtraConfig))
+	for k, v := range c.ExtraConfig {
+		clone.ExtraConfig[k] = v
+	}
+	return &clone
 }
 
 // WithProvider 设置日志提供者
@@ -71,6 +113,12 @@ func (c *LogConfig) WithOutputPath(path string) *LogConfig {
 	return c
 }
 
+// WithOutputs 设置多个日志输出，设置后OutputPath不再生效
+func (c *LogConfig) WithOutputs(outputs ...OutputConfig) *LogConfig {
+	c.Outputs = outputs
+	return c
+}
+
 // WithMaxLogSize 设置单个日志文件最大大小（MB）
 func (c *LogConfig) WithMaxLogSize(size int64) *LogConfig {
 	c.MaxLogSize = size
@@ -95,12 +143,60 @@ func (c *LogConfig) WithCompressLogs(compress bool) *LogConfig {
 	return c
 }
 
+// WithRotateInterval 设置按时间轮转的间隔
+func (c *LogConfig) WithRotateInterval(interval time.Duration) *LogConfig {
+	c.RotateInterval = interval
+	return c
+}
+
+// WithCurrentLink 设置指向当前日志文件的符号链接路径
+func (c *LogConfig) WithCurrentLink(link string) *LogConfig {
+	c.CurrentLink = link
+	return c
+}
+
 // WithMaxMessageSize 设置单条日志最大大小（KB）
 func (c *LogConfig) WithMaxMessageSize(size int) *LogConfig {
 	c.MaxMessageSize = size
 	return c
 }
 
+// WithCaller 设置是否输出调用位置
+func (c *LogConfig) WithCaller(enabled bool) *LogConfig {
+	c.Caller = enabled
+	return c
+}
+
+// WithCallerSkip 设置输出调用位置时额外跳过的调用层数
+func (c *LogConfig) WithCallerSkip(skip int) *LogConfig {
+	c.CallerSkip = skip
+	return c
+}
+
+// WithAsync 开启异步写入并设置队列容量（条），0表示同步写入
+func (c *LogConfig) WithAsync(queueSize int) *LogConfig {
+	c.AsyncQueueSize = queueSize
+	return c
+}
+
+// WithOverflowPolicy 设置异步写入队列已满时的处理方式
+func (c *LogConfig) WithOverflowPolicy(policy OverflowPolicy) *LogConfig {
+	c.OverflowPolicy = policy
+	return c
+}
+
+// WithOverflowLevel 设置OverflowDropBelowLevel时不会被丢弃的最低级别
+func (c *LogConfig) WithOverflowLevel(level LogLevel) *LogConfig {
+	c.OverflowLevel = &level
+	return c
+}
+
+// WithStackLevel 设置为级别不低于level的日志附加堆栈
+func (c *LogConfig) WithStackLevel(level LogLevel) *LogConfig {
+	c.StackLevel = &level
+	return c
+}
+
 // WithExtraConfig 设置额外配置
 func (c *LogConfig) WithExtraConfig(key string, value interface{}) *LogConfig {
 	if c.ExtraConfig == nil {
@@ -131,13 +227,33 @@ func (c *LogConfig) ToOptions() []Option {
 		WithMaxLogAge(c.MaxLogAge),
 		WithMaxLogFiles(c.MaxLogFiles),
 		WithCompressLogs(c.CompressLogs),
+		WithRotateInterval(c.RotateInterval),
+		WithCurrentLink(c.CurrentLink),
 		WithMaxMessageSize(c.MaxMessageSize),
+		WithCaller(c.Caller),
+		WithCallerSkip(c.CallerSkip),
 		WithConfig(c.ExtraConfig),
 	}
+	if len(c.Outputs) > 0 {
+		options = append(options, WithOutputs(c.Outputs...))
+	}
+	if c.StackLevel != nil {
+		options = append(options, WithStackTrace(*c.StackLevel))
+	}
+	if c.AsyncQueueSize > 0 {
+		options = append(options, WithAsync(c.AsyncQueueSize))
+	}
+	if c.OverflowPolicy != "" {
+		options = append(options, WithOverflowPolicy(c.OverflowPolicy))
+	}
+	if c.OverflowLevel != nil {
+		options = append(options, WithOverflowLevel(*c.OverflowLevel))
+	}
 	return options
 }
 
-// Validate 验证配置的有效性
+// Validate 验证配置的有效性，非法的值会被替换为默认值，总是返回true
+// 需要发现配置错误时使用ValidateStrict
 func (c *LogConfig) Validate() bool {
 	// 验证提供者
 	if c.Provider == "" {
@@ -154,6 +270,17 @@ func (c *LogConfig) Validate() bool {
 		c.OutputPath = "stdout"
 	}
 
+	// 验证多输出配置，非法的格式使用日志实例的格式，空路径输出到标准输出
+	for i := range c.Outputs {
+		output := &c.Outputs[i]
+		if output.Path == "" {
+			output.Path = "stdout"
+		}
+		if output.Format != "" && output.Format != "text" && output.Format != "json" {
+			output.Format = ""
+		}
+	}
+
 	// 验证格式
 	if c.Format == "" {
 		c.Format = "text"
@@ -174,5 +301,198 @@ func (c *LogConfig) Validate() bool {
 		c.MaxLogFiles = 10
 	}
 
+	// 验证异步写入配置
+	if c.AsyncQueueSize < 0 {
+		c.AsyncQueueSize = 0
+	}
+	if !c.OverflowPolicy.valid() {
+		c.OverflowPolicy = OverflowBlock
+	}
+
 	return true
 }
+
+// ValidateStrict 严格验证配置，返回所有非法的配置项，不会修改配置
+// 空的提供者、名称、格式和输出路径，以及为0的文件轮转配置表示使用默认值，不视为错误；
+// 提供者是否已注册由日志工厂检查
+func (c *LogConfig) ValidateStrict() error {
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidConfig, fmt.Sprintf(format, args...)))
+	}
+
+	if !c.Level.valid() {
+		invalid("unknown level %d", int(c.Level))
+	}
+	if c.Format != "" && c.Format != "text" && c.Format != "json" {
+		invalid("format %q must be text or json", c.Format)
+	}
+	if c.MaxLogSize < 0 {
+		invalid("maxLogSize must not be negative, got %d", c.MaxLogSize)
+	}
+	if c.MaxLogAge < 0 {
+		invalid("maxLogAge must not be negative, got %v", c.MaxLogAge)
+	}
+	if c.MaxLogFiles < 0 {
+		invalid("maxLogFiles must not be negative, got %d", c.MaxLogFiles)
+	}
+	if err := validateFilePattern(c.OutputPath); err != nil {
+		invalid("outputPath: %v", err)
+	}
+	if c.RotateInterval < 0 {
+		invalid("rotateInterval must not be negative, got %v", c.RotateInterval)
+	}
+	if c.MaxMessageSize < 0 {
+		invalid("maxMessageSize must not be negative, got %d", c.MaxMessageSize)
+	}
+	if c.CallerSkip < 0 {
+		invalid("callerSkip must not be negative, got %d", c.CallerSkip)
+	}
+	if c.StackLevel != nil && !c.StackLevel.valid() {
+		invalid("unknown stackLevel %d", int(*c.StackLevel))
+	}
+	if c.AsyncQueueSize < 0 {
+		invalid("asyncQueueSize must not be negative, got %d", c.AsyncQueueSize)
+	}
+	if !c.OverflowPolicy.valid() {
+		invalid("unknown overflowPolicy %q", c.OverflowPolicy)
+	}
+	if c.OverflowLevel != nil && !c.OverflowLevel.valid() {
+		invalid("unknown overflowLevel %d", int(*c.OverflowLevel))
+	}
+	for i, output := range c.Outputs {
+		for _, problem := range output.validate(fmt.Sprintf("outputs[%d]", i)) {
+			invalid("%s", problem)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// configFromOptions 将选项转换为配置，用于严格验证
+func configFromOptions(name string, provider string, options *LoggerOptions) *LogConfig {
+	config := &LogConfig{
+		Provider:       provider,
+		Name:           name,
+		Level:          options.Level,
+		Format:         options.Format,
+		OutputPath:     options.OutputPath,
+		Outputs:        options.Outputs,
+		MaxLogSize:     options.MaxLogSize,
+		MaxLogAge:      options.MaxLogAge,
+		MaxLogFiles:    options.MaxLogFiles,
+		CompressLogs:   options.CompressLogs,
+		RotateInterval: options.RotateInterval,
+		CurrentLink:    options.CurrentLink,
+		MaxMessageSize: options.MaxMessageSize,
+		Caller:         options.Caller,
+		CallerSkip:     options.CallerSkip,
+		ExtraConfig:    options.Config,
+	}
+	if options.StackTrace {
+		level := options.StackLevel
+		config.StackLevel = &level
+	}
+	if options.AsyncQueueSize != 0 {
+		level := options.OverflowLevel
+		config.AsyncQueueSize = options.AsyncQueueSize
+		config.OverflowPolicy = options.OverflowPolicy
+		config.OverflowLevel = &level
+	}
+	return config
+}
+
+// logConfigJSON 用于LogConfig的JSON编解码，时间长度和大小使用可读文本
+type logConfigJSON struct {
+	*logConfigAlias
+	MaxLogSize     json.RawMessage `json:"maxLogSize,omitempty"`
+	MaxLogAge      json.RawMessage `json:"maxLogAge,omitempty"`
+	RotateInterval json.RawMessage `json:"rotateInterval,omitempty"`
+	MaxMessageSize json.RawMessage `json:"maxMessageSize,omitempty"`
+}
+
+type logConfigAlias LogConfig
+
+// MarshalJSON 实现json.Marshaler，时间长度输出为 "7d"，大小输出为 "100MB"
+func (c LogConfig) MarshalJSON() ([]byte, error) {
+	alias := logConfigAlias(c)
+	aux := logConfigJSON{logConfigAlias: &alias}
+	aux.MaxLogSize, _ = json.Marshal(FormatSize(c.MaxLogSize * MB))
+	aux.MaxLogAge, _ = json.Marshal(FormatDuration(c.MaxLogAge))
+	if c.RotateInterval != 0 {
+		aux.RotateInterval, _ = json.Marshal(FormatDuration(c.RotateInterval))
+	}
+	aux.MaxMessageSize, _ = json.Marshal(FormatSize(int64(c.MaxMessageSize) * KB))
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON 实现json.Unmarshaler
+// maxLogAge和rotateInterval 支持 "7d"、"24h" 或纳秒数，maxLogSize 支持 "100MB" 或MB数，maxMessageSize 支持 "10KB" 或KB数
+func (c *LogConfig) UnmarshalJSON(data []byte) error {
+	aux := logConfigJSON{logConfigAlias: (*logConfigAlias)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if hasValue(aux.MaxLogSize) {
+		size, err := decodeSize(aux.MaxLogSize, MB)
+		if err != nil {
+			return fmt.Errorf("maxLogSize: %w", err)
+		}
+		c.MaxLogSize = sizeInUnits(size, MB)
+	}
+	if hasValue(aux.MaxLogAge) {
+		age, err := decodeDuration(aux.MaxLogAge)
+		if err != nil {
+			return fmt.Errorf("maxLogAge: %w", err)
+		}
+		c.MaxLogAge = age
+	}
+	if hasValue(aux.RotateInterval) {
+		interval, err := decodeDuration(aux.RotateInterval)
+		if err != nil {
+			return fmt.Errorf("rotateInterval: %w", err)
+		}
+		c.RotateInterval = interval
+	}
+	if hasValue(aux.MaxMessageSize) {
+		size, err := decodeSize(aux.MaxMessageSize, KB)
+		if err != nil {
+			return fmt.Errorf("maxMessageSize: %w", err)
+		}
+		c.MaxMessageSize = int(sizeInUnits(size, KB))
+	}
+	return nil
+}
+
+// hasValue 判断JSON字段是否存在且不为null
+func hasValue(raw json.RawMessage) bool {
+	return len(raw) > 0 && string(raw) != "null"
+}
+
+// decodeSize 解码JSON中的大小，数字按unit计算
+func decodeSize(raw json.RawMessage, unit int64) (int64, error) {
+	if raw[0] == '"' {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return 0, err
+		}
+		return ParseSize(text, unit)
+	}
+	return ParseSize(string(raw), unit)
+}
+
+// decodeDuration 解码JSON中的时间长度，数字按纳秒计算
+func decodeDuration(raw json.RawMessage) (time.Duration, error) {
+	if raw[0] == '"' {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return 0, err
+		}
+		return ParseDuration(text)
+	}
+	n, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %s", raw)
+	}
+	return time.Duration(n), nil
+}
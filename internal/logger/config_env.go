@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvPrefix 日志配置环境变量的前缀
+//
+// 支持的环境变量：
+//
+//	LANDC_LOG_LEVEL           所有日志实例的级别
+//	LANDC_LOG_FORMAT          所有日志实例的格式（text/json）
+//	LANDC_LOG_OUTPUT          所有日志实例的输出路径，替换配置中的多输出
+//	LANDC_LOG_<NAME>_LEVEL    指定名称日志实例的级别，优先于LANDC_LOG_LEVEL
+//	LANDC_LOG_<NAME>_FORMAT   指定名称日志实例的格式
+//	LANDC_LOG_<NAME>_OUTPUT   指定名称日志实例的输出路径，替换配置中的多输出
+//
+// 设置了多输出时OutputPath不生效，因此输出路径的覆盖项会清除多输出，所有日志都写入该路径
+//
+// <NAME>为日志名称转为大写，字母和数字以外的字符替换为下划线，如"payments.db"对应PAYMENTS_DB
+const EnvPrefix = "LANDC_LOG_"
+
+// 环境变量覆盖的配置项
+const (
+	envLevel  = "LEVEL"
+	envFormat = "FORMAT"
+	envOutput = "OUTPUT"
+)
+
+// envName 将日志名称转换为环境变量中使用的形式
+func envName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// lookupEnv 查找配置项的环境变量，名称相关的变量优先，空值视为未设置
+func lookupEnv(name string, key string) (variable string, value string, ok bool) {
+	candidates := []string{EnvPrefix + key}
+	if name != "" {
+		candidates = append([]string{EnvPrefix + envName(name) + "_" + key}, candidates...)
+	}
+	for _, variable := range candidates {
+		if value, ok := os.LookupEnv(variable); ok && value != "" {
+			return variable, value, true
+		}
+	}
+	return "", "", false
+}
+
+// envOverrides 读取指定名称日志实例的环境变量覆盖项，只包含设置了的项
+func envOverrides(name string) (map[string]interface{}, error) {
+	overrides := make(map[string]interface{})
+	if variable, value, ok := lookupEnv(name, envLevel); ok {
+		level, err := ParseLevel(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", variable, err)
+		}
+		overrides["level"] = level
+	}
+	if _, value, ok := lookupEnv(name, envFormat); ok {
+		overrides["format"] = strings.ToLower(value)
+	}
+	if _, value, ok := lookupEnv(name, envOutput); ok {
+		overrides["outputPath"] = value
+	}
+	return overrides, nil
+}
+
+// ApplyEnv 使用环境变量覆盖配置中的级别、格式和输出路径，覆盖输出路径时清除多输出
+// 环境变量中的级别无法解析时返回错误，配置保持不变
+func (c *LogConfig) ApplyEnv() error {
+	overrides, err := envOverrides(c.Name)
+	if err != nil {
+		return err
+	}
+	if level, ok := overrides["level"].(LogLevel); ok {
+		c.Level = level
+	}
+	if format, ok := overrides["format"].(string); ok {
+		c.Format = format
+	}
+	if outputPath, ok := overrides["outputPath"].(string); ok {
+		c.OutputPath = outputPath
+		c.Outputs = nil
+	}
+	return nil
+}
+
+// EnvOptions 返回指定名称日志实例的环境变量覆盖项对应的选项
+func EnvOptions(name string) ([]Option, error) {
+	overrides, err := envOverrides(name)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]Option, 0, len(overrides))
+	if level, ok := overrides["level"].(LogLevel); ok {
+		options = append(options, WithLevel(level))
+	}
+	if format, ok := overrides["format"].(string); ok {
+		options = append(options, WithFormat(format))
+	}
+	if outputPath, ok := overrides["outputPath"].(string); ok {
+		options = append(options, WithOutputPath(outputPath), WithOutputs())
+	}
+	return options, nil
+}
+
+// applyEnvToMap 返回叠加了环境变量覆盖项的配置map副本
+func applyEnvToMap(name string, config map[string]interface{}) (map[string]interface{}, error) {
+	overrides, err := envOverrides(name)
+	if err != nil || len(overrides) == 0 {
+		return config, err
+	}
+
+	merged := make(map[string]interface{}, len(config)+len(overrides))
+	for k, v := range config {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	if _, ok := overrides["outputPath"]; ok {
+		delete(merged, "outputs")
+	}
+	return merged, nil
+}
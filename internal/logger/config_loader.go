@@ -0,0 +1,164 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// 配置文件格式
+const (
+	ConfigFormatJSON = "json"
+	ConfigFormatYAML = "yaml"
+	ConfigFormatTOML = "toml"
+)
+
+// ConfigFormatFromPath 根据文件扩展名推断配置文件格式
+func ConfigFormatFromPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ConfigFormatJSON, nil
+	case ".yaml", ".yml":
+		return ConfigFormatYAML, nil
+	case ".toml":
+		return ConfigFormatTOML, nil
+	default:
+		return "", fmt.Errorf("cannot detect config format of %q", path)
+	}
+}
+
+// LoadConfigFile 从JSON、YAML或TOML文件加载日志配置，格式由扩展名决定
+func LoadConfigFile(path string) (*LogConfig, error) {
+	var config *LogConfig
+	err := readConfigFile(path, func(r io.Reader, format string) (err error) {
+		config, err = LoadConfig(r, format)
+		return err
+	})
+	return config, err
+}
+
+// LoadLoggingConfigFile 从JSON、YAML或TOML文件加载多日志实例配置文档
+func LoadLoggingConfigFile(path string) (*LoggingConfig, error) {
+	var config *LoggingConfig
+	err := readConfigFile(path, func(r io.Reader, format string) (err error) {
+		config, err = LoadLoggingConfig(r, format)
+		return err
+	})
+	return config, err
+}
+
+// readConfigFile 打开配置文件并交给load解析
+func readConfigFile(path string, load func(r io.Reader, format string) error) error {
+	format, err := ConfigFormatFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := load(file, format); err != nil {
+		return fmt.Errorf("load %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadConfig 从reader中按指定格式加载日志配置
+// 未出现在配置中的项保留NewLogConfig的默认值，配置项非法时返回ValidateStrict的错误
+func LoadConfig(r io.Reader, format string) (*LogConfig, error) {
+	data, err := decodeConfigJSON(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	config := NewLogConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateStrict(); err != nil {
+		return nil, err
+	}
+	config.Validate()
+	return config, nil
+}
+
+// LoadLoggingConfig 从reader中按指定格式加载多日志实例配置文档，配置项非法时返回错误
+func LoadLoggingConfig(r io.Reader, format string) (*LoggingConfig, error) {
+	data, err := decodeConfigJSON(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	config := NewLoggingConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateStrict(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// decodeConfigJSON 将任意格式的配置解码后统一转换为JSON
+// 这样LogConfig只需维护一套json标签和文本编解码逻辑
+func decodeConfigJSON(r io.Reader, format string) ([]byte, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]interface{}
+	switch strings.ToLower(format) {
+	case ConfigFormatJSON:
+		return raw, nil
+	case ConfigFormatYAML, "yml":
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+	case ConfigFormatTOML:
+		if err := toml.NewDecoder(bytes.NewReader(raw)).Decode(&doc); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config format %q", format)
+	}
+
+	if doc == nil {
+		doc = make(map[string]interface{})
+	}
+	return json.Marshal(normalizeConfigValue(doc))
+}
+
+// normalizeConfigValue 将YAML解码出的map[interface{}]interface{}转换为可JSON编码的形式
+func normalizeConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeConfigValue(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalizeConfigValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeConfigValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,274 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DecodeConfigMap 将配置map转换为选项，供各日志提供者的CreateWithConfig共用
+// 除了精确的Go类型，也接受JSON等解码出的字符串和数字：
+// level 可以是 "debug" 或数字，maxLogSize/maxMessageSize 可以是 "100MB"、"10KB" 或按MB/KB计的数字，
+// maxLogAge/rotateInterval 可以是 "24h"、"7d" 或纳秒数，compressLogs 可以是 "true"，outputs 可以是对象数组。
+// 只为map中存在的项生成选项，无法转换的项会被跳过并在返回的错误中列出，
+// 整个map总会通过WithConfig传给提供者
+func DecodeConfigMap(config map[string]interface{}) ([]Option, error) {
+	var errs []error
+	opts := make([]Option, 0, len(config)+1)
+
+	decode := func(key string, apply func(value interface{}) (Option, error)) {
+		value, ok := config[key]
+		if !ok || value == nil {
+			return
+		}
+		opt, err := apply(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s: %v", ErrInvalidConfig, key, err))
+			return
+		}
+		opts = append(opts, opt)
+	}
+
+	decode("level", func(value interface{}) (Option, error) {
+		level, err := toLevel(value)
+		return WithLevel(level), err
+	})
+	decode("format", func(value interface{}) (Option, error) {
+		format, err := toString(value)
+		return WithFormat(format), err
+	})
+	decode("outputPath", func(value interface{}) (Option, error) {
+		path, err := toString(value)
+		return WithOutputPath(path), err
+	})
+	decode("outputs", func(value interface{}) (Option, error) {
+		outputs, err := toOutputs(value)
+		return WithOutputs(outputs...), err
+	})
+	decode("maxLogSize", func(value interface{}) (Option, error) {
+		size, err := toSize(value, MB)
+		return WithMaxLogSize(sizeInUnits(size, MB)), err
+	})
+	decode("maxLogAge", func(value interface{}) (Option, error) {
+		age, err := toDuration(value)
+		return WithMaxLogAge(age), err
+	})
+	decode("maxLogFiles", func(value interface{}) (Option, error) {
+		files, err := toInt(value)
+		return WithMaxLogFiles(int(files)), err
+	})
+	decode("compressLogs", func(value interface{}) (Option, error) {
+		compress, err := toBool(value)
+		return WithCompressLogs(compress), err
+	})
+	decode("rotateInterval", func(value interface{}) (Option, error) {
+		interval, err := toDuration(value)
+		return WithRotateInterval(interval), err
+	})
+	decode("currentLink", func(value interface{}) (Option, error) {
+		link, err := toString(value)
+		return WithCurrentLink(link), err
+	})
+	decode("maxMessageSize", func(value interface{}) (Option, error) {
+		size, err := toSize(value, KB)
+		return WithMaxMessageSize(int(sizeInUnits(size, KB))), err
+	})
+	decode("caller", func(value interface{}) (Option, error) {
+		enabled, err := toBool(value)
+		return WithCaller(enabled), err
+	})
+	decode("callerSkip", func(value interface{}) (Option, error) {
+		skip, err := toInt(value)
+		if err == nil && skip < 0 {
+			err = fmt.Errorf("must not be negative, got %d", skip)
+		}
+		return WithCallerSkip(int(skip)), err
+	})
+	decode("stackLevel", func(value interface{}) (Option, error) {
+		level, err := toLevel(value)
+		return WithStackTrace(level), err
+	})
+	decode("asyncQueueSize", func(value interface{}) (Option, error) {
+		size, err := toInt(value)
+		if err == nil && size < 0 {
+			err = fmt.Errorf("must not be negative, got %d", size)
+		}
+		return WithAsync(int(size)), err
+	})
+	decode("overflowPolicy", func(value interface{}) (Option, error) {
+		policy, err := toOverflowPolicy(value)
+		return WithOverflowPolicy(policy), err
+	})
+	decode("overflowLevel", func(value interface{}) (Option, error) {
+		level, err := toLevel(value)
+		return WithOverflowLevel(level), err
+	})
+
+	opts = append(opts, WithConfig(config))
+	return opts, errors.Join(errs...)
+}
+
+// toLevel 将级别名称或数字转换为日志级别，数字按ParseLevel的规则解析
+func toLevel(value interface{}) (LogLevel, error) {
+	switch v := value.(type) {
+	case LogLevel:
+		return v, nil
+	case string:
+		return ParseLevel(v)
+	case fmt.Stringer:
+		return ParseLevel(v.String())
+	}
+	n, err := toInt(value)
+	if err != nil {
+		return InfoLevel, err
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return InfoLevel, fmt.Errorf("unknown log level %d", n)
+	}
+	return levelFromInt(int(n))
+}
+
+// toOverflowPolicy 转换异步写入队列已满时的处理方式
+func toOverflowPolicy(value interface{}) (OverflowPolicy, error) {
+	if policy, ok := value.(OverflowPolicy); ok {
+		return ParseOverflowPolicy(string(policy))
+	}
+	text, err := toString(value)
+	if err != nil {
+		return "", err
+	}
+	return ParseOverflowPolicy(text)
+}
+
+// toOutputs 转换多输出配置，接受[]OutputConfig以及JSON等解码出的对象数组
+func toOutputs(value interface{}) ([]OutputConfig, error) {
+	if outputs, ok := value.([]OutputConfig); ok {
+		return outputs, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var outputs []OutputConfig
+	if err := json.Unmarshal(data, &outputs); err != nil {
+		return nil, err
+	}
+	var problems []string
+	for i, output := range outputs {
+		problems = append(problems, output.validate(fmt.Sprintf("[%d]", i))...)
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "; "))
+	}
+	return outputs, nil
+}
+
+// toString 转换字符串，接受字符串和实现了fmt.Stringer的类型（如LogLevel），其他类型返回错误
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("expected string, got %T", value)
+	}
+}
+
+// toInt 将各种整数、整数值的浮点数和数字字符串转换为int64
+func toInt(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return int64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("%d overflows int64", v)
+		}
+		return int64(v), nil
+	case float32:
+		return floatToInt(float64(v))
+	case float64:
+		return floatToInt(v)
+	case json.Number:
+		return strconv.ParseInt(v.String(), 10, 64)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer %q", v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("expected integer, got %T", value)
+	}
+}
+
+// floatToInt 将没有小数部分的浮点数转换为int64
+func floatToInt(f float64) (int64, error) {
+	if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, fmt.Errorf("%v is not an integer", f)
+	}
+	return int64(f), nil
+}
+
+// toSize 将大小转换为字节数，字符串可以带单位，数字按unit计算
+func toSize(value interface{}, unit int64) (int64, error) {
+	if text, ok := value.(string); ok {
+		return ParseSize(text, unit)
+	}
+	n, err := toInt(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("invalid size %d", n)
+	}
+	return n * unit, nil
+}
+
+// toDuration 将时间长度转换为time.Duration，字符串支持 "24h"、"7d" 等形式，数字按纳秒计算
+func toDuration(value interface{}) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		return ParseDuration(v)
+	}
+	n, err := toInt(value)
+	return time.Duration(n), err
+}
+
+// toBool 将布尔值或 "true"/"false" 等字符串转换为bool
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("invalid boolean %q", v)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("expected boolean, got %T", value)
+	}
+}
@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 字节大小单位
+const (
+	KB int64 = 1 << 10
+	MB int64 = 1 << 20
+	GB int64 = 1 << 30
+)
+
+// ParseDuration 解析可读的时间长度
+// 在time.ParseDuration的基础上额外支持 "d"（天）和 "w"（周），如 "7d"、"1w12h"
+func ParseDuration(text string) (time.Duration, error) {
+	s := strings.TrimSpace(text)
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+
+	var total time.Duration
+	rest := s
+	for rest != "" {
+		// 找到下一个 d/w 单位，之前的部分交给time.ParseDuration
+		idx := strings.IndexAny(rest, "dw")
+		if idx < 0 {
+			d, err := time.ParseDuration(rest)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", text)
+			}
+			return total + d, nil
+		}
+
+		n, err := strconv.ParseFloat(rest[:idx], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", text)
+		}
+		unit := 24 * time.Hour
+		if rest[idx] == 'w' {
+			unit *= 7
+		}
+		total += time.Duration(n * float64(unit))
+		rest = rest[idx+1:]
+	}
+	return total, nil
+}
+
+// FormatDuration 将时间长度格式化为可读文本，整天数输出为 "7d" 形式
+func FormatDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	if d > 0 && d%day == 0 {
+		return strconv.FormatInt(int64(d/day), 10) + "d"
+	}
+	return d.String()
+}
+
+// ParseSize 解析可读的字节大小，如 "512KB"、"100MB"、"1GB"，返回字节数
+// 不带单位的数字按unit计算
+func ParseSize(text string, unit int64) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(text))
+	if s == "" {
+		return 0, fmt.Errorf("empty size")
+	}
+
+	multiplier := unit
+	for _, suffix := range []struct {
+		name string
+		size int64
+	}{
+		{"GB", GB}, {"G", GB},
+		{"MB", MB}, {"M", MB},
+		{"KB", KB}, {"K", KB},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(s, suffix.name) {
+			multiplier = suffix.size
+			s = strings.TrimSpace(strings.TrimSuffix(s, suffix.name))
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", text)
+	}
+	return int64(n * float64(multiplier)), nil
+}
+
+// FormatSize 将字节数格式化为可读文本，如 "100MB"
+func FormatSize(bytes int64) string {
+	switch {
+	case bytes != 0 && bytes%GB == 0:
+		return strconv.FormatInt(bytes/GB, 10) + "GB"
+	case bytes != 0 && bytes%MB == 0:
+		return strconv.FormatInt(bytes/MB, 10) + "MB"
+	case bytes != 0 && bytes%KB == 0:
+		return strconv.FormatInt(bytes/KB, 10) + "KB"
+	default:
+		return strconv.FormatInt(bytes, 10) + "B"
+	}
+}
+
+// sizeInUnits 将字节数换算为指定单位，不足一个单位的部分向上取整
+func sizeInUnits(bytes, unit int64) int64 {
+	return (bytes + unit - 1) / unit
+}
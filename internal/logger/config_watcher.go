@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultWatchInterval 默认的配置文件检查间隔
+const defaultWatchInterval = 5 * time.Second
+
+// ConfigWatcher 定期检查配置文件，文件变化时重新加载并应用到日志工厂中已有的日志实例
+type ConfigWatcher struct {
+	factory  *LogFactory
+	path     string
+	interval time.Duration
+
+	mu       sync.Mutex
+	modTime  time.Time
+	size     int64
+	started  bool
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewConfigWatcher 创建配置文件监视器
+// interval 为检查间隔，小于等于0时使用默认的5秒
+func NewConfigWatcher(factory *LogFactory, path string, interval time.Duration) *ConfigWatcher {
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	return &ConfigWatcher{
+		factory:  factory,
+		path:     path,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 立即加载并应用一次配置文件，成功后开始在后台监视文件变化
+func (w *ConfigWatcher) Start() error {
+	if err := w.Reload(); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.started {
+		w.started = true
+		go w.run()
+	}
+	return nil
+}
+
+// Stop 停止监视，可以重复调用
+func (w *ConfigWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+
+	w.mu.Lock()
+	started := w.started
+	w.mu.Unlock()
+	if started {
+		<-w.done
+	}
+}
+
+// Reload 立即重新加载配置文件并应用
+// 加载失败时保留当前配置不变，直到文件再次发生变化
+func (w *ConfigWatcher) Reload() error {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	w.modTime = info.ModTime()
+	w.size = info.Size()
+	w.mu.Unlock()
+
+	config, err := LoadLoggingConfigFile(w.path)
+	if err != nil {
+		return err
+	}
+	return w.factory.Reload(config)
+}
+
+// run 定期检查文件的修改时间和大小
+func (w *ConfigWatcher) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			if w.changed() {
+				ReportError(w.Reload())
+			}
+		}
+	}
+}
+
+// changed 判断配置文件自上次加载后是否发生变化
+func (w *ConfigWatcher) changed() bool {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		ReportError(err)
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return !info.ModTime().Equal(w.modTime) || info.Size() != w.size
+}
+
+// WatchConfigFile 加载配置文件并应用到全局日志工厂，之后每隔interval检查一次文件变化
+func WatchConfigFile(path string, interval time.Duration) (*ConfigWatcher, error) {
+	watcher := NewConfigWatcher(GetLogFactory(), path, interval)
+	if err := watcher.Start(); err != nil {
+		return nil, err
+	}
+	return watcher, nil
+}
@@ -5,82 +5,143 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
+	"runtime"
+	"sync"
 	"time"
-
-	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // ConsoleLogger 默认的控制台日志适配器
 type ConsoleLogger struct {
-	level          LogLevel
-	fields         []Field
-	ctx            context.Context
-	logger         *log.Logger
-	name           string
-	format         string // 日志格式（text/json）
-	maxMessageSize int    // 单条日志最大大小（KB）
+	core   *consoleCore // 通过With系列方法和Named派生的实例共享同一个core
+	level  *AtomicLevel // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	fields []Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// consoleCore 控制台日志可在运行时重新配置的状态
+type consoleCore struct {
+	mu             sync.RWMutex
+	outputs        []consoleOutput
+	closed         bool     // 已调用Close且之后没有重新配置
+	maxMessageSize int      // 单条日志最大大小（KB）
+	caller         bool     // 是否输出调用位置
+	callerSkip     int      // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool     // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     LogLevel // 附加堆栈的最低级别
+}
+
+// consoleOutput 控制台日志的一个输出
+type consoleOutput struct {
+	Output
+	logger *log.Logger
+	below  *log.Logger // 写入BelowLevelWriter，用于低于OverflowLevel的日志，队列已满时丢弃；为nil表示不需要
+}
+
+// loggerFor 返回写入级别为level的日志使用的实例
+func (o consoleOutput) loggerFor(level LogLevel) *log.Logger {
+	if o.below != nil && o.BelowLevel(level) {
+		return o.below
+	}
+	return o.logger
 }
 
 // NewConsoleLogger 创建控制台日志实例
 func NewConsoleLogger(name string, opts ...Option) *ConsoleLogger {
-	options := &LoggerOptions{
-		Level:          InfoLevel,
-		Format:         "text",
-		OutputPath:     "stdout",
-		MaxLogSize:     100,                // 默认100MB
-		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
-		MaxLogFiles:    10,                 // 默认10个文件
-		CompressLogs:   false,              // 默认不压缩
-		MaxMessageSize: 0,                  // 默认不限制
-		Config:         make(map[string]interface{}),
+	options := NewLoggerOptions(opts...)
+	core := &consoleCore{}
+	core.apply(options)
+
+	return &ConsoleLogger{
+		core:   core,
+		level:  NewLoggerLevel(name, options),
+		fields: make([]Field, 0),
+		ctx:    context.Background(),
+		name:   name,
 	}
+}
 
-	for _, opt := range opts {
-		opt(options)
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *consoleCore) apply(options *LoggerOptions) []Output {
+	outputs := ReplaceOutputs(c.currentOutputs(), options)
+	consoleOutputs := make([]consoleOutput, len(outputs))
+	for i, output := range outputs {
+		consoleOutputs[i] = consoleOutput{Output: output, logger: log.New(output.Writer, "", 0)}
+		if below := output.BelowLevelWriter(); below != nil {
+			consoleOutputs[i].below = log.New(below, "", 0)
+		}
 	}
 
-	var output interface {
-		Write(p []byte) (n int, err error)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		old[i] = output.Output
 	}
-	if options.OutputPath == "stdout" {
-		output = os.Stdout
-	} else {
-		// 使用lumberjack进行日志轮转
-		output = &lumberjack.Logger{
-			Filename:   options.OutputPath,
-			MaxSize:    int(options.MaxLogSize),             // MB
-			MaxAge:     int(options.MaxLogAge.Hours() / 24), // 天
-			MaxBackups: options.MaxLogFiles,
-			Compress:   options.CompressLogs,
-		}
+	c.outputs = consoleOutputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	return old
+}
+
+// currentOutputs 返回当前使用的所有输出
+func (c *consoleCore) currentOutputs() []Output {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	outputs := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
 	}
+	return outputs
+}
 
-	return &ConsoleLogger{
-		level:          options.Level,
-		fields:         make([]Field, 0),
-		ctx:            context.Background(),
-		logger:         log.New(output, "", 0),
-		name:           name,
-		format:         options.Format,
-		maxMessageSize: options.MaxMessageSize,
+// close 标记为已关闭并关闭所有输出
+func (c *consoleCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	c.mu.Unlock()
+	return CloseOutputs(outputs)
+}
+
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (c *ConsoleLogger) Reconfigure(opts ...Option) error {
+	options := NewLoggerOptions(opts...)
+	if options.AtomicLevel == nil {
+		c.level.SetLevel(options.Level)
 	}
+	old := c.core.apply(options)
+	return CloseOutputs(old)
 }
 
 // SetLevel 设置日志级别
 func (c *ConsoleLogger) SetLevel(level LogLevel) {
-	c.level = level
+	c.level.SetLevel(level)
 }
 
 // GetLevel 获取当前日志级别
 func (c *ConsoleLogger) GetLevel() LogLevel {
+	return c.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (c *ConsoleLogger) AtomicLevel() *AtomicLevel {
 	return c.level
 }
 
 // limitMessageSize 限制日志消息大小
 func (c *ConsoleLogger) limitMessageSize(msg string) string {
-	if c.maxMessageSize > 0 {
-		maxSize := c.maxMessageSize * 1024 // 转换为字节
+	if c.core.maxMessageSize > 0 {
+		maxSize := c.core.maxMessageSize * 1024 // 转换为字节
 		if len(msg) > maxSize {
 			return msg[:maxSize-3] + "..."
 		}
@@ -88,22 +149,34 @@ func (c *ConsoleLogger) limitMessageSize(msg string) string {
 	return msg
 }
 
-// formatMessage 格式化日志消息
-func (c *ConsoleLogger) formatMessage(level LogLevel, msg string, fields []Field) string {
+// formatMessage 格式化日志消息，caller为nil时不输出调用位置，stack为空时不输出堆栈
+// 文本格式的堆栈以缩进块的形式输出在日志下方，不计入单条日志大小的限制
+func (c *ConsoleLogger) formatMessage(format string, level LogLevel, msg string, fields []Field, caller *runtime.Frame, stack string) string {
 	timestamp := time.Now().Format("2006-01-02 15:04:05.000")
-	allFields := append(c.fields, fields...)
+	allFields := ExpandErrors(AppendFields(c.fields, fields))
+	location := ""
+	if caller != nil {
+		location = " " + FormatCaller(*caller) + " " + ShortFunction(caller.Function)
+	}
 
-	if c.format == "json" {
+	if format == "json" {
 		// 构建JSON格式的日志
 		jsonFields := make(map[string]interface{})
 		jsonFields["time"] = timestamp
 		jsonFields["level"] = level.String()
 		jsonFields["logger"] = c.name
 		jsonFields["msg"] = msg
+		if caller != nil {
+			jsonFields["caller"] = FormatCaller(*caller)
+			jsonFields["func"] = caller.Function
+		}
+		if stack != "" {
+			jsonFields[StackKey] = stack
+		}
 
 		// 添加所有字段
 		for _, field := range allFields {
-			jsonFields[field.Key] = field.Value
+			jsonFields[field.Key] = field.Any()
 		}
 
 		// 转换为JSON字符串
@@ -112,10 +185,10 @@ func (c *ConsoleLogger) formatMessage(level LogLevel, msg string, fields []Field
 			// 如果JSON转换失败，回退到文本格式
 			fieldStr := ""
 			for _, field := range allFields {
-				fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Value)
+				fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Any())
 			}
-			formattedMsg := fmt.Sprintf("%s [%s] [%s] %s%s", timestamp, level.String(), c.name, msg, fieldStr)
-			return c.limitMessageSize(formattedMsg)
+			formattedMsg := fmt.Sprintf("%s [%s] [%s]%s %s%s", timestamp, level.String(), c.name, location, msg, fieldStr)
+			return c.appendStack(c.limitMessageSize(formattedMsg), stack)
 		}
 
 		formattedMsg := string(jsonBytes)
@@ -124,114 +197,274 @@ func (c *ConsoleLogger) formatMessage(level LogLevel, msg string, fields []Field
 		// 文本格式
 		fieldStr := ""
 		for _, field := range allFields {
-			fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Value)
+			fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Any())
 		}
 
-		formattedMsg := fmt.Sprintf("%s [%s] [%s] %s%s", timestamp, level.String(), c.name, msg, fieldStr)
-		return c.limitMessageSize(formattedMsg)
+		formattedMsg := fmt.Sprintf("%s [%s] [%s]%s %s%s", timestamp, level.String(), c.name, location, msg, fieldStr)
+		return c.appendStack(c.limitMessageSize(formattedMsg), stack)
+	}
+}
+
+// appendStack 在文本格式的日志下方追加缩进的堆栈
+func (c *ConsoleLogger) appendStack(formatted string, stack string) string {
+	if stack == "" {
+		return formatted
+	}
+	return formatted + "\n" + IndentStack(stack, "\t")
+}
+
+// output 在级别启用时格式化并输出日志，返回格式化后的内容
+func (c *ConsoleLogger) output(ctx context.Context, level LogLevel, msg string, fields []Field) string {
+	if !c.level.Enabled(level) {
+		return ""
+	}
+	fields = WithContextFields(ctx, fields)
+
+	c.core.mu.RLock()
+	defer c.core.mu.RUnlock()
+
+	var caller *runtime.Frame
+	if c.core.caller {
+		if frame, ok := CallerFrame(c.core.callerSkip); ok {
+			caller = &frame
+		}
+	}
+	stack := ""
+	if c.stack || (c.core.stackTrace && level.Severity() >= c.core.stackLevel.Severity()) {
+		stack = Stack(c.core.callerSkip)
+	}
+
+	// 每种格式只格式化一次，返回第一个输出的格式化结果
+	formatted := make(map[string]string, 1)
+	format := func(output Output) string {
+		message, ok := formatted[output.Format]
+		if !ok {
+			message = c.formatMessage(output.Format, level, msg, fields, caller, stack)
+			formatted[output.Format] = message
+		}
+		return message
+	}
+	for _, output := range c.core.outputs {
+		if output.Enabled(level) {
+			output.loggerFor(level).Println(format(output.Output))
+		}
+	}
+	return format(c.core.outputs[0].Output)
+}
+
+// Trace 输出跟踪级日志
+func (c *ConsoleLogger) Trace(msg string, fields ...Field) {
+	c.output(c.ctx, TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (c *ConsoleLogger) Tracef(format string, args ...interface{}) {
+	if c.IsTraceEnabled() {
+		c.output(c.ctx, TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if c.IsTraceEnabled() {
+		c.Trace(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Debug 输出调试级日志
 func (c *ConsoleLogger) Debug(msg string, fields ...Field) {
-	if c.level <= DebugLevel {
-		c.logger.Println(c.formatMessage(DebugLevel, msg, fields))
-	}
+	c.output(c.ctx, DebugLevel, msg, fields)
 }
 
 // Debugf 输出格式化的调试级日志
 func (c *ConsoleLogger) Debugf(format string, args ...interface{}) {
-	if c.level <= DebugLevel {
-		msg := fmt.Sprintf(format, args...)
-		c.logger.Println(c.formatMessage(DebugLevel, msg, nil))
+	if c.IsDebugEnabled() {
+		c.output(c.ctx, DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if c.IsDebugEnabled() {
+		c.Debug(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Info 输出信息级日志
 func (c *ConsoleLogger) Info(msg string, fields ...Field) {
-	if c.level <= InfoLevel {
-		c.logger.Println(c.formatMessage(InfoLevel, msg, fields))
-	}
+	c.output(c.ctx, InfoLevel, msg, fields)
 }
 
 // Infof 输出格式化的信息级日志
 func (c *ConsoleLogger) Infof(format string, args ...interface{}) {
-	if c.level <= InfoLevel {
-		msg := fmt.Sprintf(format, args...)
-		c.logger.Println(c.formatMessage(InfoLevel, msg, nil))
+	if c.IsInfoEnabled() {
+		c.output(c.ctx, InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if c.IsInfoEnabled() {
+		c.Info(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Warn 输出警告级日志
 func (c *ConsoleLogger) Warn(msg string, fields ...Field) {
-	if c.level <= WarnLevel {
-		c.logger.Println(c.formatMessage(WarnLevel, msg, fields))
-	}
+	c.output(c.ctx, WarnLevel, msg, fields)
 }
 
 // Warnf 输出格式化的警告级日志
 func (c *ConsoleLogger) Warnf(format string, args ...interface{}) {
-	if c.level <= WarnLevel {
-		msg := fmt.Sprintf(format, args...)
-		c.logger.Println(c.formatMessage(WarnLevel, msg, nil))
+	if c.IsWarnEnabled() {
+		c.output(c.ctx, WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if c.IsWarnEnabled() {
+		c.Warn(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Error 输出错误级日志
 func (c *ConsoleLogger) Error(msg string, fields ...Field) {
-	if c.level <= ErrorLevel {
-		c.logger.Println(c.formatMessage(ErrorLevel, msg, fields))
-	}
+	c.output(c.ctx, ErrorLevel, msg, fields)
 }
 
 // Errorf 输出格式化的错误级日志
 func (c *ConsoleLogger) Errorf(format string, args ...interface{}) {
-	if c.level <= ErrorLevel {
-		msg := fmt.Sprintf(format, args...)
-		c.logger.Println(c.formatMessage(ErrorLevel, msg, nil))
+	if c.IsErrorEnabled() {
+		c.output(c.ctx, ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if c.IsErrorEnabled() {
+		c.Error(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Fatal 输出致命级日志并退出程序
 func (c *ConsoleLogger) Fatal(msg string, fields ...Field) {
-	if c.level <= FatalLevel {
-		c.logger.Println(c.formatMessage(FatalLevel, msg, fields))
-		os.Exit(1)
+	if c.IsFatalEnabled() {
+		c.output(c.ctx, FatalLevel, msg, fields)
+		c.exit()
 	}
 }
 
 // Fatalf 输出格式化的致命级日志并退出程序
 func (c *ConsoleLogger) Fatalf(format string, args ...interface{}) {
-	if c.level <= FatalLevel {
-		msg := fmt.Sprintf(format, args...)
-		c.logger.Println(c.formatMessage(FatalLevel, msg, nil))
-		os.Exit(1)
+	if c.IsFatalEnabled() {
+		c.output(c.ctx, FatalLevel, fmt.Sprintf(format, args...), nil)
+		c.exit()
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if c.IsFatalEnabled() {
+		c.Fatal(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Panic 输出恐慌级日志并触发panic
 func (c *ConsoleLogger) Panic(msg string, fields ...Field) {
-	if c.level <= PanicLevel {
-		msg := c.formatMessage(PanicLevel, msg, fields)
-		c.logger.Println(msg)
-		panic(msg)
+	if c.IsPanicEnabled() {
+		panic(c.output(c.ctx, PanicLevel, msg, fields))
 	}
 }
 
 // Panicf 输出格式化的恐慌级日志并触发panic
 func (c *ConsoleLogger) Panicf(format string, args ...interface{}) {
-	if c.level <= PanicLevel {
-		msg := fmt.Sprintf(format, args...)
-		fullMsg := c.formatMessage(PanicLevel, msg, nil)
-		c.logger.Println(fullMsg)
-		panic(fullMsg)
+	if c.IsPanicEnabled() {
+		panic(c.output(c.ctx, PanicLevel, fmt.Sprintf(format, args...), nil))
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if c.IsPanicEnabled() {
+		c.Panic(msg, KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (c *ConsoleLogger) Log(level LogLevel, msg string, fields ...Field) {
+	c.LogContext(c.ctx, level, msg, fields...)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (c *ConsoleLogger) Logf(level LogLevel, format string, args ...interface{}) {
+	if c.IsLevelEnabled(level) {
+		c.Log(level, fmt.Sprintf(format, args...))
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (c *ConsoleLogger) Logw(level LogLevel, msg string, keysAndValues ...interface{}) {
+	if c.IsLevelEnabled(level) {
+		c.Log(level, msg, KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) TraceContext(ctx context.Context, msg string, fields ...Field) {
+	c.output(ctx, TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) DebugContext(ctx context.Context, msg string, fields ...Field) {
+	c.output(ctx, DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) InfoContext(ctx context.Context, msg string, fields ...Field) {
+	c.output(ctx, InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) WarnContext(ctx context.Context, msg string, fields ...Field) {
+	c.output(ctx, WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) ErrorContext(ctx context.Context, msg string, fields ...Field) {
+	c.output(ctx, ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (c *ConsoleLogger) LogContext(ctx context.Context, level LogLevel, msg string, fields ...Field) {
+	switch level {
+	case FatalLevel:
+		if c.IsFatalEnabled() {
+			c.output(ctx, FatalLevel, msg, fields)
+			c.exit()
+		}
+	case PanicLevel:
+		if c.IsPanicEnabled() {
+			panic(c.output(ctx, PanicLevel, msg, fields))
+		}
+	default:
+		c.output(ctx, level, msg, fields)
 	}
 }
 
 // WithFields 添加字段到日志
 func (c *ConsoleLogger) WithFields(fields ...Field) Logger {
 	newLogger := *c
-	newLogger.fields = append(newLogger.fields, fields...)
+	newLogger.fields = AppendFields(c.fields, fields)
+	return &newLogger
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (c *ConsoleLogger) Named(name string) Logger {
+	newLogger := *c
+	newLogger.name = JoinLoggerName(c.name, name)
+	newLogger.level = NewNamedLevel(newLogger.name, c.level)
 	return &newLogger
 }
 
@@ -249,47 +482,85 @@ func (c *ConsoleLogger) WithContext(ctx context.Context) Logger {
 
 // WithError 添加错误信息到日志
 func (c *ConsoleLogger) WithError(err error) Logger {
-	return c.WithField("error", err)
+	return c.WithFields(Err(err))
 }
 
 // WithTime 添加时间到日志
 func (c *ConsoleLogger) WithTime(t time.Time) Logger {
-	return c.WithField("time", t)
+	return c.WithFields(Time("time", t))
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (c *ConsoleLogger) WithStack() Logger {
+	newLogger := *c
+	newLogger.stack = true
+	return &newLogger
+}
+
+// IsTraceEnabled 检查跟踪级别是否启用
+func (c *ConsoleLogger) IsTraceEnabled() bool {
+	return c.level.Enabled(TraceLevel)
 }
 
 // IsDebugEnabled 检查调试级别是否启用
 func (c *ConsoleLogger) IsDebugEnabled() bool {
-	return c.level <= DebugLevel
+	return c.level.Enabled(DebugLevel)
 }
 
 // IsInfoEnabled 检查信息级别是否启用
 func (c *ConsoleLogger) IsInfoEnabled() bool {
-	return c.level <= InfoLevel
+	return c.level.Enabled(InfoLevel)
 }
 
 // IsWarnEnabled 检查警告级别是否启用
 func (c *ConsoleLogger) IsWarnEnabled() bool {
-	return c.level <= WarnLevel
+	return c.level.Enabled(WarnLevel)
 }
 
 // IsErrorEnabled 检查错误级别是否启用
 func (c *ConsoleLogger) IsErrorEnabled() bool {
-	return c.level <= ErrorLevel
+	return c.level.Enabled(ErrorLevel)
 }
 
 // IsFatalEnabled 检查致命级别是否启用
 func (c *ConsoleLogger) IsFatalEnabled() bool {
-	return c.level <= FatalLevel
+	return c.level.Enabled(FatalLevel)
 }
 
 // IsPanicEnabled 检查恐慌级别是否启用
 func (c *ConsoleLogger) IsPanicEnabled() bool {
-	return c.level <= PanicLevel
+	return c.level.Enabled(PanicLevel)
 }
 
-// Sync 刷新日志缓冲区
+// IsLevelEnabled 检查指定级别是否启用
+func (c *ConsoleLogger) IsLevelEnabled(level LogLevel) bool {
+	return c.level.Enabled(level)
+}
+
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
 func (c *ConsoleLogger) Sync() error {
-	return nil
+	return SyncOutputs(c.core.currentOutputs())
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (c *ConsoleLogger) Close() error {
+	return c.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (c *ConsoleLogger) Closed() bool {
+	c.core.mu.RLock()
+	defer c.core.mu.RUnlock()
+	return c.core.closed
+}
+
+// exit 写入致命级日志后刷新输出并退出程序
+func (c *ConsoleLogger) exit() {
+	if err := c.Sync(); err != nil {
+		ReportError(err)
+	}
+	Exit(1)
 }
 
 // ConsoleLoggerProvider 控制台日志提供者
@@ -306,72 +577,9 @@ func (p *ConsoleLoggerProvider) Create(name string, opts ...Option) Logger {
 }
 
 // CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
 func (p *ConsoleLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) Logger {
-	var level LogLevel
-	if lvl, ok := config["level"].(LogLevel); ok {
-		level = lvl
-	} else {
-		level = InfoLevel
-	}
-
-	var format string
-	if fmt, ok := config["format"].(string); ok {
-		format = fmt
-	} else {
-		format = "text"
-	}
-
-	var outputPath string
-	if path, ok := config["outputPath"].(string); ok {
-		outputPath = path
-	} else {
-		outputPath = "stdout"
-	}
-
-	var maxLogSize int64
-	if size, ok := config["maxLogSize"].(int64); ok {
-		maxLogSize = size
-	} else {
-		maxLogSize = 100
-	}
-
-	var maxLogAge time.Duration
-	if age, ok := config["maxLogAge"].(time.Duration); ok {
-		maxLogAge = age
-	} else {
-		maxLogAge = 7 * 24 * time.Hour
-	}
-
-	var maxLogFiles int
-	if files, ok := config["maxLogFiles"].(int); ok {
-		maxLogFiles = files
-	} else {
-		maxLogFiles = 10
-	}
-
-	var compressLogs bool
-	if compress, ok := config["compressLogs"].(bool); ok {
-		compressLogs = compress
-	} else {
-		compressLogs = false
-	}
-
-	var maxMessageSize int
-	if size, ok := config["maxMessageSize"].(int); ok {
-		maxMessageSize = size
-	} else {
-		maxMessageSize = 0
-	}
-
-	return NewConsoleLogger(name,
-		WithLevel(level),
-		WithFormat(format),
-		WithOutputPath(outputPath),
-		WithMaxLogSize(maxLogSize),
-		WithMaxLogAge(maxLogAge),
-		WithMaxLogFiles(maxLogFiles),
-		WithCompressLogs(compressLogs),
-		WithMaxMessageSize(maxMessageSize),
-		WithConfig(config),
-	)
+	opts, err := DecodeConfigMap(config)
+	ReportError(err)
+	return NewConsoleLogger(name, opts...)
 }
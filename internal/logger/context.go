@@ -0,0 +1,189 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ContextExtractor 从上下文中提取日志字段，上下文中没有相关数据时返回nil
+// 注册的提取器对所有日志实例生效，提取的字段出现在实例的字段之后、本次调用的字段之前
+type ContextExtractor func(ctx context.Context) []Field
+
+// namedExtractor 带名称的上下文提取器
+type namedExtractor struct {
+	name    string
+	extract ContextExtractor
+}
+
+// 已注册的上下文提取器，写时复制，输出日志时无需加锁
+var (
+	contextExtractorsMu sync.Mutex
+	contextExtractors   atomic.Pointer[[]namedExtractor]
+)
+
+// 内置上下文数据的字段名
+const (
+	TraceIDKey   = "trace_id"
+	RequestIDKey = "request_id"
+	UserIDKey    = "user_id"
+	TenantIDKey  = "tenant_id"
+)
+
+// contextKey 内置上下文数据的键类型，避免与其他包冲突
+type contextKey string
+
+// init 注册内置的上下文提取器
+func init() {
+	for _, key := range []string{TraceIDKey, RequestIDKey, UserIDKey, TenantIDKey} {
+		RegisterContextExtractor(key, ContextKeyExtractor(key, contextKey(key)))
+	}
+}
+
+// RegisterContextExtractor 注册上下文提取器，同名的提取器会被替换
+// 内置了trace_id、request_id、user_id和tenant_id四个提取器，分别读取ContextWithTraceID等函数设置的值
+func RegisterContextExtractor(name string, extractor ContextExtractor) {
+	contextExtractorsMu.Lock()
+	defer contextExtractorsMu.Unlock()
+
+	var extractors []namedExtractor
+	if current := contextExtractors.Load(); current != nil {
+		extractors = make([]namedExtractor, 0, len(*current)+1)
+		for _, e := range *current {
+			if e.name != name {
+				extractors = append(extractors, e)
+			}
+		}
+	}
+	extractors = append(extractors, namedExtractor{name: name, extract: extractor})
+	contextExtractors.Store(&extractors)
+}
+
+// UnregisterContextExtractor 注销上下文提取器
+func UnregisterContextExtractor(name string) {
+	contextExtractorsMu.Lock()
+	defer contextExtractorsMu.Unlock()
+
+	current := contextExtractors.Load()
+	if current == nil {
+		return
+	}
+	extractors := make([]namedExtractor, 0, len(*current))
+	for _, e := range *current {
+		if e.name != name {
+			extractors = append(extractors, e)
+		}
+	}
+	contextExtractors.Store(&extractors)
+}
+
+// ContextFields 使用所有注册的提取器从上下文中提取字段，供日志提供者在输出时使用
+func ContextFields(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+	extractors := contextExtractors.Load()
+	if extractors == nil {
+		return nil
+	}
+	var fields []Field
+	for _, e := range *extractors {
+		fields = append(fields, e.extract(ctx)...)
+	}
+	return fields
+}
+
+// WithContextFields 返回从上下文提取的字段加上fields，上下文中没有数据时直接返回fields
+// 供日志提供者在输出时使用
+func WithContextFields(ctx context.Context, fields []Field) []Field {
+	if ctxFields := ContextFields(ctx); len(ctxFields) > 0 {
+		return append(ctxFields, fields...)
+	}
+	return fields
+}
+
+// ContextKeyExtractor 创建读取ctx.Value(key)的提取器，值不为nil时输出名为field的字段
+func ContextKeyExtractor(field string, key interface{}) ContextExtractor {
+	return func(ctx context.Context) []Field {
+		switch value := ctx.Value(key).(type) {
+		case nil:
+			return nil
+		case string:
+			if value == "" {
+				return nil
+			}
+			return []Field{String(field, value)}
+		default:
+			return []Field{Any(field, value)}
+		}
+	}
+}
+
+// ContextWithTraceID 返回携带追踪ID的上下文，日志中输出为trace_id字段
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, contextKey(TraceIDKey), traceID)
+}
+
+// TraceIDFromContext 获取上下文中的追踪ID
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(contextKey(TraceIDKey)).(string)
+	return traceID
+}
+
+// ContextWithRequestID 返回携带请求ID的上下文，日志中输出为request_id字段
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey(RequestIDKey), requestID)
+}
+
+// RequestIDFromContext 获取上下文中的请求ID
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey(RequestIDKey)).(string)
+	return requestID
+}
+
+// ContextWithUserID 返回携带用户ID的上下文，日志中输出为user_id字段
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, contextKey(UserIDKey), userID)
+}
+
+// UserIDFromContext 获取上下文中的用户ID
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(contextKey(UserIDKey)).(string)
+	return userID
+}
+
+// ContextWithTenantID 返回携带租户ID的上下文，日志中输出为tenant_id字段
+func ContextWithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKey(TenantIDKey), tenantID)
+}
+
+// TenantIDFromContext 获取上下文中的租户ID
+func TenantIDFromContext(ctx context.Context) string {
+	tenantID, _ := ctx.Value(contextKey(TenantIDKey)).(string)
+	return tenantID
+}
+
+// loggerKey 上下文中保存日志实例的键
+type loggerKey struct{}
+
+// NewContext 返回保存了日志实例的上下文，之后可以通过FromContext取出
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// LoggerFromContext 返回上下文中保存的日志实例，没有时返回false
+func LoggerFromContext(ctx context.Context) (Logger, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	l, ok := ctx.Value(loggerKey{}).(Logger)
+	return l, ok
+}
+
+// FromContext 返回上下文中保存的日志实例，没有时返回全局日志实例
+func FromContext(ctx context.Context) Logger {
+	if l, ok := LoggerFromContext(ctx); ok {
+		return l
+	}
+	return GetLogger()
+}
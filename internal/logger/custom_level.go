@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// customLevelBase 第一个自定义级别的数值，之后的自定义级别按注册顺序依次分配
+const customLevelBase LogLevel = 100
+
+// customLevels 通过RegisterLevel注册的自定义级别
+var customLevels = struct {
+	sync.RWMutex
+	names      map[LogLevel]string
+	levels     map[string]LogLevel
+	severities map[LogLevel]int
+}{
+	names:      make(map[LogLevel]string),
+	levels:     make(map[string]LogLevel),
+	severities: make(map[LogLevel]int),
+}
+
+// RegisterLevel 注册自定义日志级别，返回为它分配的级别以便保存为变量
+// name为级别的字符串形式，不区分大小写，统一保存为大写；severity为严重程度，决定它与其他级别的先后，
+// 内置级别的严重程度参见LogLevel.Severity，例如在InfoLevel和WarnLevel之间注册NOTICE：
+//
+//	var NoticeLevel, _ = RegisterLevel("NOTICE", InfoLevel.Severity()+5)
+//
+// 名称和严重程度都不能与内置级别或其他自定义级别冲突，以相同的名称和严重程度重复注册返回同一个级别。
+// 自定义级别的数值从100开始按注册顺序分配，配置中应使用级别名称
+func RegisterLevel(name string, severity int) (LogLevel, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" || strings.ContainsAny(name, " \t\r\n") {
+		return InfoLevel, fmt.Errorf("invalid level name %q", name)
+	}
+	if _, err := strconv.Atoi(name); err == nil {
+		return InfoLevel, fmt.Errorf("invalid level name %q", name)
+	}
+	if level, err := ParseLevel(name); err == nil && level.builtin() {
+		return InfoLevel, fmt.Errorf("level name %q is reserved", name)
+	}
+	if severity == math.MinInt || severity == math.MaxInt {
+		return InfoLevel, fmt.Errorf("invalid level severity %d", severity)
+	}
+	for level := TraceLevel; level <= PanicLevel; level++ {
+		if level.Severity() == severity {
+			return InfoLevel, fmt.Errorf("level severity %d is already used by %s", severity, level)
+		}
+	}
+
+	customLevels.Lock()
+	defer customLevels.Unlock()
+	if existing, ok := customLevels.levels[name]; ok {
+		if customLevels.severities[existing] == severity {
+			return existing, nil
+		}
+		return InfoLevel, fmt.Errorf("level %s is already registered with severity %d", name, customLevels.severities[existing])
+	}
+	for level, existing := range customLevels.severities {
+		if existing == severity {
+			return InfoLevel, fmt.Errorf("level severity %d is already used by %s", severity, customLevels.names[level])
+		}
+	}
+	level := customLevelBase + LogLevel(len(customLevels.names))
+	customLevels.names[level] = name
+	customLevels.levels[name] = level
+	customLevels.severities[level] = severity
+	return level, nil
+}
+
+// MustRegisterLevel 与RegisterLevel相同，注册失败时panic，适合在包级变量中使用
+func MustRegisterLevel(name string, severity int) LogLevel {
+	level, err := RegisterLevel(name, severity)
+	if err != nil {
+		panic(err)
+	}
+	return level
+}
+
+// CustomLevels 返回所有已注册的自定义级别，按严重程度从低到高排列
+func CustomLevels() []LogLevel {
+	customLevels.RLock()
+	defer customLevels.RUnlock()
+	levels := make([]LogLevel, 0, len(customLevels.names))
+	for level := range customLevels.names {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return customLevels.severities[levels[i]] < customLevels.severities[levels[j]]
+	})
+	return levels
+}
+
+// customLevelName 返回自定义级别的名称
+func customLevelName(level LogLevel) (string, bool) {
+	customLevels.RLock()
+	defer customLevels.RUnlock()
+	name, ok := customLevels.names[level]
+	return name, ok
+}
+
+// customLevelSeverity 返回自定义级别的严重程度
+func customLevelSeverity(level LogLevel) (int, bool) {
+	customLevels.RLock()
+	defer customLevels.RUnlock()
+	severity, ok := customLevels.severities[level]
+	return severity, ok
+}
+
+// customLevelByName 按大写名称查找自定义级别
+func customLevelByName(name string) (LogLevel, bool) {
+	customLevels.RLock()
+	defer customLevels.RUnlock()
+	level, ok := customLevels.levels[name]
+	return level, ok
+}
+
+// BaseLevel 返回不高于level的最近的内置级别，供没有对应原生级别的日志提供者映射自定义级别
+// 低于TraceLevel的级别返回TraceLevel
+func BaseLevel(level LogLevel) LogLevel {
+	builtin := []LogLevel{PanicLevel, FatalLevel, ErrorLevel, WarnLevel, InfoLevel, DebugLevel}
+	for _, b := range builtin {
+		if level.Severity() >= b.Severity() {
+			return b
+		}
+	}
+	return TraceLevel
+}
+
+// IsCustomLevel 判断是否为已注册的自定义级别
+func IsCustomLevel(level LogLevel) bool {
+	_, ok := customLevelName(level)
+	return ok
+}
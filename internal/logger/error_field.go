@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"fmt"
+)
+
+// maxErrorCauses 展开错误时最多输出的被包装错误数量，防止循环包装
+const maxErrorCauses = 32
+
+// LogFielder 可以提供自身结构化字段的错误，输出错误字段时这些字段会一起输出
+//
+//	type QueryError struct{ SQL string; Err error }
+//
+//	func (e *QueryError) LogFields() []Field { return []Field{String("sql", e.SQL)} }
+type LogFielder interface {
+	LogFields() []Field
+}
+
+// ErrorFields 将错误展开为日志字段
+// key为错误信息，key_type为错误的类型名；通过Unwrap或errors.Join包装了其他错误时，
+// key_causes按深度优先的顺序列出它们的错误信息；错误本身或被包装的错误实现了LogFielder时，
+// 它们的字段也会一起输出
+func ErrorFields(key string, err error) []Field {
+	if err == nil {
+		return []Field{{Key: key}}
+	}
+
+	causes := errorCauses(err)
+	fields := []Field{
+		String(key, errorMessage(err)),
+		String(key+"_type", fmt.Sprintf("%T", err)),
+	}
+	if len(causes) > 0 {
+		messages := make(stringArray, len(causes))
+		for i, cause := range causes {
+			messages[i] = errorMessage(cause)
+		}
+		fields = append(fields, Array(key+"_causes", messages))
+	}
+	for _, e := range append([]error{err}, causes...) {
+		if fielder, ok := e.(LogFielder); ok {
+			fields = append(fields, fielder.LogFields()...)
+		}
+	}
+	return fields
+}
+
+// ExpandErrors 展开fields中的错误字段，包括值为error的Any字段，没有错误字段时直接返回fields
+// 供日志提供者在输出前使用
+func ExpandErrors(fields []Field) []Field {
+	first := -1
+	for i, field := range fields {
+		if _, ok := fieldError(field); ok {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return fields
+	}
+
+	expanded := make([]Field, first, len(fields)+4)
+	copy(expanded, fields[:first])
+	for _, field := range fields[first:] {
+		if err, ok := fieldError(field); ok {
+			expanded = append(expanded, ErrorFields(field.Key, err)...)
+		} else {
+			expanded = append(expanded, field)
+		}
+	}
+	return expanded
+}
+
+// fieldError 返回错误字段的错误
+func fieldError(field Field) (error, bool) {
+	switch field.Type {
+	case ErrorType:
+		return field.Value.(error), true
+	case AnyType:
+		err, ok := field.Value.(error)
+		return err, ok
+	default:
+		return nil, false
+	}
+}
+
+// errorCauses 按深度优先的顺序返回err通过Unwrap和errors.Join包装的所有错误，不包括err本身
+func errorCauses(err error) []error {
+	var causes []error
+	var walk func(err error)
+	walk = func(err error) {
+		var wrapped []error
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			wrapped = []error{e.Unwrap()}
+		case interface{ Unwrap() []error }:
+			wrapped = e.Unwrap()
+		}
+		for _, cause := range wrapped {
+			if cause == nil || len(causes) >= maxErrorCauses {
+				continue
+			}
+			causes = append(causes, cause)
+			walk(cause)
+		}
+	}
+	walk(err)
+	return causes
+}
+
+// errorMessage 调用Error方法，nil指针或panic时返回描述信息
+func errorMessage(err error) (message string) {
+	defer func() {
+		if r := recover(); r != nil {
+			message = fmt.Sprintf("<PANIC=%v>", r)
+		}
+	}()
+	return err.Error()
+}
+
+// stringArray 字符串数组字段的值
+type stringArray []string
+
+// MarshalLogArray 实现ArrayMarshaler
+func (s stringArray) MarshalLogArray(enc ArrayEncoder) error {
+	for _, value := range s {
+		enc.AppendString(value)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+var (
+	// ErrInvalidConfig 日志配置中存在非法的值
+	ErrInvalidConfig = errors.New("invalid log config")
+	// ErrUnknownProvider 日志提供者未注册
+	ErrUnknownProvider = errors.New("unknown log provider")
+	// ErrInvalidKeyValues Infow等方法传入的键值对中存在缺少值或不是字符串的键
+	ErrInvalidKeyValues = errors.New("invalid key-value pairs")
+)
+
+// ErrorHandler 处理日志库内部错误的函数，例如配置重新加载失败
+type ErrorHandler func(err error)
+
+var (
+	errorHandler   ErrorHandler = defaultErrorHandler
+	errorHandlerMu sync.RWMutex
+)
+
+// defaultErrorHandler 默认将内部错误输出到标准错误
+func defaultErrorHandler(err error) {
+	fmt.Fprintf(os.Stderr, "landc-logface: %v\n", err)
+}
+
+// SetErrorHandler 设置内部错误处理函数，传入nil恢复默认处理
+func SetErrorHandler(handler ErrorHandler) {
+	errorHandlerMu.Lock()
+	defer errorHandlerMu.Unlock()
+	if handler == nil {
+		handler = defaultErrorHandler
+	}
+	errorHandler = handler
+}
+
+// ReportError 将内部错误交给错误处理函数
+func ReportError(err error) {
+	if err == nil {
+		return
+	}
+	errorHandlerMu.RLock()
+	handler := errorHandler
+	errorHandlerMu.RUnlock()
+	handler(err)
+}
@@ -0,0 +1,278 @@
+package logger
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// FieldType 日志字段值的类型
+type FieldType uint8
+
+const (
+	// AnyType 任意类型，值保存在Value中，直接构造的Field{Key, Value}都是这种类型
+	AnyType FieldType = iota
+	// StringType 字符串
+	StringType
+	// Int64Type 整数
+	Int64Type
+	// Float64Type 浮点数
+	Float64Type
+	// BoolType 布尔值
+	BoolType
+	// DurationType 时间长度
+	DurationType
+	// TimeType 时间，以纳秒时间戳和时区保存
+	TimeType
+	// TimeFullType 超出纳秒时间戳范围的时间
+	TimeFullType
+	// ErrorType 错误
+	ErrorType
+	// StringerType 实现了fmt.Stringer的值，输出时才调用String
+	StringerType
+	// ObjectType 实现了ObjectMarshaler的值
+	ObjectType
+	// ArrayType 实现了ArrayMarshaler的值
+	ArrayType
+)
+
+// Field 定义日志字段
+// 通过String、Int64等构造函数创建的字段把值保存在Integer、Str中，不会装箱为interface{}，
+// 日志提供者可以按Type直接映射为原生的类型化字段；需要interface{}形式的值时使用Any方法
+type Field struct {
+	Key     string
+	Value   interface{} // AnyType的值，或错误、Stringer、时区等本身就是接口的值
+	Type    FieldType
+	Integer int64  // 整数、布尔值、时间长度、时间戳以及浮点数的位
+	Str     string // 字符串
+}
+
+// ObjectEncoder 编码对象字段的键值对，zap的zapcore.ObjectEncoder也满足该接口
+type ObjectEncoder interface {
+	AddString(key, value string)
+	AddInt64(key string, value int64)
+	AddFloat64(key string, value float64)
+	AddBool(key string, value bool)
+	AddDuration(key string, value time.Duration)
+	AddTime(key string, value time.Time)
+	AddReflected(key string, value interface{}) error
+}
+
+// ObjectMarshaler 可以将自身编码为对象的值，用于Object字段
+type ObjectMarshaler interface {
+	MarshalLogObject(enc ObjectEncoder) error
+}
+
+// ArrayEncoder 编码数组字段的元素，zap的zapcore.ArrayEncoder也满足该接口
+type ArrayEncoder interface {
+	AppendString(value string)
+	AppendInt64(value int64)
+	AppendFloat64(value float64)
+	AppendBool(value bool)
+	AppendDuration(value time.Duration)
+	AppendTime(value time.Time)
+	AppendReflected(value interface{}) error
+}
+
+// ArrayMarshaler 可以将自身编码为数组的值，用于Array字段
+type ArrayMarshaler interface {
+	MarshalLogArray(enc ArrayEncoder) error
+}
+
+// String 创建字符串字段
+func String(key string, value string) Field {
+	return Field{Key: key, Type: StringType, Str: value}
+}
+
+// Int 创建整数字段
+func Int(key string, value int) Field {
+	return Int64(key, int64(value))
+}
+
+// Int64 创建整数字段
+func Int64(key string, value int64) Field {
+	return Field{Key: key, Type: Int64Type, Integer: value}
+}
+
+// Float64 创建浮点数字段
+func Float64(key string, value float64) Field {
+	return Field{Key: key, Type: Float64Type, Integer: int64(math.Float64bits(value))}
+}
+
+// Bool 创建布尔字段
+func Bool(key string, value bool) Field {
+	var integer int64
+	if value {
+		integer = 1
+	}
+	return Field{Key: key, Type: BoolType, Integer: integer}
+}
+
+// Duration 创建时间长度字段
+func Duration(key string, value time.Duration) Field {
+	return Field{Key: key, Type: DurationType, Integer: int64(value)}
+}
+
+// 纳秒时间戳能表示的时间范围
+var (
+	minTimeInt64 = time.Unix(0, math.MinInt64)
+	maxTimeInt64 = time.Unix(0, math.MaxInt64)
+)
+
+// Time 创建时间字段
+func Time(key string, value time.Time) Field {
+	if value.Before(minTimeInt64) || value.After(maxTimeInt64) {
+		return Field{Key: key, Type: TimeFullType, Value: value}
+	}
+	return Field{Key: key, Type: TimeType, Integer: value.UnixNano(), Value: value.Location()}
+}
+
+// Err 创建键为 "error" 的错误字段，err为nil时字段值为nil
+func Err(err error) Field {
+	return NamedErr("error", err)
+}
+
+// NamedErr 创建指定键的错误字段
+func NamedErr(key string, err error) Field {
+	if err == nil {
+		return Field{Key: key}
+	}
+	return Field{Key: key, Type: ErrorType, Value: err}
+}
+
+// Stringer 创建在输出时才调用String方法的字段
+func Stringer(key string, value fmt.Stringer) Field {
+	if value == nil {
+		return Field{Key: key}
+	}
+	return Field{Key: key, Type: StringerType, Value: value}
+}
+
+// Object 创建对象字段
+func Object(key string, value ObjectMarshaler) Field {
+	if value == nil {
+		return Field{Key: key}
+	}
+	return Field{Key: key, Type: ObjectType, Value: value}
+}
+
+// Array 创建数组字段
+func Array(key string, value ArrayMarshaler) Field {
+	if value == nil {
+		return Field{Key: key}
+	}
+	return Field{Key: key, Type: ArrayType, Value: value}
+}
+
+// Any 创建任意类型的字段，与Field{Key: key, Value: value}相同
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Any 返回字段的interface{}形式的值，供没有类型化字段的日志提供者使用
+// 错误字段返回错误信息，对象和数组字段分别编码为map[string]interface{}和[]interface{}
+func (f Field) Any() interface{} {
+	switch f.Type {
+	case StringType:
+		return f.Str
+	case Int64Type:
+		return f.Integer
+	case Float64Type:
+		return math.Float64frombits(uint64(f.Integer))
+	case BoolType:
+		return f.Integer == 1
+	case DurationType:
+		return time.Duration(f.Integer)
+	case TimeType:
+		t := time.Unix(0, f.Integer)
+		if loc, ok := f.Value.(*time.Location); ok {
+			t = t.In(loc)
+		}
+		return t
+	case ErrorType:
+		return errorMessage(f.Value.(error))
+	case StringerType:
+		return stringerValue(f.Value.(fmt.Stringer))
+	case ObjectType:
+		enc := make(MapObjectEncoder)
+		if err := f.Value.(ObjectMarshaler).MarshalLogObject(enc); err != nil {
+			enc["error"] = err.Error()
+		}
+		return map[string]interface{}(enc)
+	case ArrayType:
+		enc := &SliceArrayEncoder{}
+		if err := f.Value.(ArrayMarshaler).MarshalLogArray(enc); err != nil {
+			enc.Elems = append(enc.Elems, err.Error())
+		}
+		return enc.Elems
+	default:
+		// AnyType和TimeFullType的值都保存在Value中
+		return f.Value
+	}
+}
+
+// stringerValue 调用String方法，nil指针或panic时返回描述信息
+func stringerValue(s fmt.Stringer) (value string) {
+	defer func() {
+		if r := recover(); r != nil {
+			value = fmt.Sprintf("<PANIC=%v>", r)
+		}
+	}()
+	return s.String()
+}
+
+// MapObjectEncoder 将对象编码为map的ObjectEncoder
+type MapObjectEncoder map[string]interface{}
+
+// AddString 实现ObjectEncoder
+func (m MapObjectEncoder) AddString(key, value string) { m[key] = value }
+
+// AddInt64 实现ObjectEncoder
+func (m MapObjectEncoder) AddInt64(key string, value int64) { m[key] = value }
+
+// AddFloat64 实现ObjectEncoder
+func (m MapObjectEncoder) AddFloat64(key string, value float64) { m[key] = value }
+
+// AddBool 实现ObjectEncoder
+func (m MapObjectEncoder) AddBool(key string, value bool) { m[key] = value }
+
+// AddDuration 实现ObjectEncoder
+func (m MapObjectEncoder) AddDuration(key string, value time.Duration) { m[key] = value }
+
+// AddTime 实现ObjectEncoder
+func (m MapObjectEncoder) AddTime(key string, value time.Time) { m[key] = value }
+
+// AddReflected 实现ObjectEncoder
+func (m MapObjectEncoder) AddReflected(key string, value interface{}) error {
+	m[key] = value
+	return nil
+}
+
+// SliceArrayEncoder 将数组编码为切片的ArrayEncoder
+type SliceArrayEncoder struct {
+	Elems []interface{}
+}
+
+// AppendString 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendString(value string) { s.Elems = append(s.Elems, value) }
+
+// AppendInt64 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendInt64(value int64) { s.Elems = append(s.Elems, value) }
+
+// AppendFloat64 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendFloat64(value float64) { s.Elems = append(s.Elems, value) }
+
+// AppendBool 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendBool(value bool) { s.Elems = append(s.Elems, value) }
+
+// AppendDuration 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendDuration(value time.Duration) { s.Elems = append(s.Elems, value) }
+
+// AppendTime 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendTime(value time.Time) { s.Elems = append(s.Elems, value) }
+
+// AppendReflected 实现ArrayEncoder
+func (s *SliceArrayEncoder) AppendReflected(value interface{}) error {
+	s.Elems = append(s.Elems, value)
+	return nil
+}
@@ -0,0 +1,434 @@
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// rotationSettings 共享文件的轮转配置
+type rotationSettings struct {
+	maxSize  int64         // 单个日志文件最大大小（MB）
+	maxAge   time.Duration // 日志文件最大保留时间
+	maxFiles int           // 最大保留日志文件数量
+	compress bool          // 是否压缩旧日志
+	interval time.Duration // 按时间轮转的间隔，0表示只按大小轮转
+	link     string        // 指向当前日志文件的符号链接，为空时不创建
+}
+
+// String 返回轮转配置的描述，用于报告配置冲突
+func (s rotationSettings) String() string {
+	return fmt.Sprintf("maxSize=%dMB maxAge=%s maxFiles=%d compress=%t interval=%s link=%q",
+		s.maxSize, s.maxAge, s.maxFiles, s.compress, s.interval, s.link)
+}
+
+// newRotationSettings 从options中读取轮转配置，路径包含时间模式且未设置间隔时按模式中最小的时间单位轮转
+func newRotationSettings(options *LoggerOptions) rotationSettings {
+	settings := rotationSettings{
+		maxSize:  options.MaxLogSize,
+		maxAge:   options.MaxLogAge,
+		maxFiles: options.MaxLogFiles,
+		compress: options.CompressLogs,
+		interval: options.RotateInterval,
+		link:     options.CurrentLink,
+	}
+	if settings.interval <= 0 && hasFilePattern(options.OutputPath) {
+		settings.interval = patternInterval(options.OutputPath)
+	}
+	return settings
+}
+
+// sharedFile 进程内同一路径共享的日志文件，所有日志实例和提供者通过同一个lumberjack写入和轮转
+// lumberjack只负责写入和移走备份，按大小轮转的时机以及旧文件的保留和压缩都由这里处理，
+// 因此lumberjack的后台清理协程不会读取任何配置，轮转配置可以随时修改而无需替换lumberjack
+type sharedFile struct {
+	key     string
+	path    string                                 // 配置的路径，可以包含时间模式
+	holders map[*sharedFileWriter]rotationSettings // 打开该文件的写入器及其轮转配置，由fileRegistryMu保护
+
+	mu       sync.Mutex
+	settings rotationSettings
+	logger   *lumberjack.Logger
+	size     int64     // 当前文件已写入的大小，-1表示lumberjack尚未打开当前文件
+	next     time.Time // 下一次按时间轮转的时间，不按时间轮转时为零值
+	closed   bool      // 所有写入器都已关闭，之后的写入不会重新打开文件
+
+	millMu  sync.Mutex     // 保证同一时间只有一次清理
+	milling sync.WaitGroup // 正在进行的清理，关闭文件时等待它们完成
+}
+
+// fileRegistry 进程内打开过的日志文件，文件关闭后仍保留在注册表中，再次打开时沿用同一个lumberjack，
+// 避免每次打开都遗留一个lumberjack的后台协程
+var (
+	fileRegistry   = make(map[string]*sharedFile)
+	fileRegistryMu sync.Mutex
+)
+
+// fileKey 返回日志文件在注册表中的键，同一文件的不同写法（相对路径、多余的分隔符等）得到相同的键
+func fileKey(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// openSharedFile 返回path对应的共享文件写入器
+// replaced为同一日志实例重新配置前使用的写入器，它们的轮转配置会被新的配置替换；
+// 文件还被其他日志实例以不同的轮转配置打开时通过ReportError报告冲突，保留原有配置
+func openSharedFile(options *LoggerOptions, replaced map[*sharedFileWriter]bool) *sharedFileWriter {
+	key := fileKey(options.OutputPath)
+	settings := newRotationSettings(options)
+
+	fileRegistryMu.Lock()
+	defer fileRegistryMu.Unlock()
+	file, ok := fileRegistry[key]
+	if !ok {
+		file = &sharedFile{key: key, path: options.OutputPath, holders: make(map[*sharedFileWriter]rotationSettings)}
+		fileRegistry[key] = file
+	}
+	writer := &sharedFileWriter{file: file}
+	if conflict, ok := file.conflict(settings, replaced); ok {
+		ReportError(fmt.Errorf("log file %s is already open with different rotation settings (%s), ignoring %s",
+			file.path, conflict, settings))
+	} else {
+		file.configure(settings)
+	}
+	file.holders[writer] = settings
+	return writer
+}
+
+// conflict 返回其他日志实例打开该文件时使用的与settings不一致的轮转配置，调用时需持有fileRegistryMu
+func (f *sharedFile) conflict(settings rotationSettings, replaced map[*sharedFileWriter]bool) (rotationSettings, bool) {
+	for holder, held := range f.holders {
+		if held != settings && !replaced[holder] {
+			return held, true
+		}
+	}
+	return rotationSettings{}, false
+}
+
+// agreed 返回所有写入器一致使用的轮转配置，调用时需持有fileRegistryMu
+func (f *sharedFile) agreed() (rotationSettings, bool) {
+	var settings rotationSettings
+	first := true
+	for _, held := range f.holders {
+		if !first && held != settings {
+			return rotationSettings{}, false
+		}
+		settings, first = held, false
+	}
+	return settings, !first
+}
+
+// configure 应用轮转配置，第一次调用时创建lumberjack，之后沿用同一个实例
+func (f *sharedFile) configure(settings rotationSettings) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	reopened := f.closed
+	f.closed = false
+	if f.logger != nil && settings == f.settings && !reopened {
+		return
+	}
+	if f.logger == nil {
+		f.logger = &lumberjack.Logger{}
+		f.size = -1
+	}
+	f.settings = settings
+	f.logger.MaxSize = int(settings.maxSize) // MB
+	if !hasFilePattern(f.path) {
+		f.logger.Filename = f.path
+	}
+	f.next = time.Time{}
+	if settings.interval > 0 {
+		f.rotate(time.Now())
+	}
+	f.updateLink()
+}
+
+// maxBytes 返回单个文件的最大字节数，未设置时与lumberjack相同为100MB
+func (f *sharedFile) maxBytes() int64 {
+	if f.settings.maxSize > 0 {
+		return f.settings.maxSize * MB
+	}
+	return 100 * MB
+}
+
+// rotate 进入now所在的轮转周期，调用时需持有f.mu
+// 路径包含时间模式时切换到新的文件名，否则把上一周期写入的文件移为备份
+func (f *sharedFile) rotate(now time.Time) {
+	start, next := rotationPeriod(now, f.settings.interval)
+	f.next = next
+
+	if !hasFilePattern(f.path) {
+		if info, err := os.Stat(f.path); err == nil && info.Size() > 0 && info.ModTime().Before(start) {
+			f.rotateFile()
+		}
+		return
+	}
+
+	filename := formatFilePattern(f.path, start)
+	if filename == f.logger.Filename {
+		return
+	}
+	// 沿用同一个lumberjack，只切换文件名
+	if err := f.logger.Close(); err != nil {
+		ReportError(err)
+	}
+	f.logger.Filename = filename
+	f.size = -1
+	f.updateLink()
+	f.startMill()
+}
+
+// rotateFile 把当前文件移为备份并开始写入新文件，调用时需持有f.mu
+func (f *sharedFile) rotateFile() {
+	if err := f.logger.Rotate(); err != nil {
+		ReportError(err)
+	}
+	f.size = 0
+	f.startMill()
+}
+
+// startMill 在后台清理旧文件，调用时需持有f.mu
+func (f *sharedFile) startMill() {
+	current, settings := f.logger.Filename, f.settings
+	f.milling.Add(1)
+	go func() {
+		defer f.milling.Done()
+		f.mill(current, settings)
+	}()
+}
+
+// updateLink 将符号链接指向当前日志文件，调用时需持有f.mu
+func (f *sharedFile) updateLink() {
+	link := f.settings.link
+	if link == "" {
+		return
+	}
+	target, err := filepath.Rel(filepath.Dir(fileKey(link)), fileKey(f.logger.Filename))
+	if err != nil {
+		target = fileKey(f.logger.Filename)
+	}
+	if err := os.MkdirAll(filepath.Dir(link), 0755); err != nil {
+		ReportError(err)
+		return
+	}
+	// 先创建临时链接再重命名，替换过程中链接始终存在
+	tmp := link + ".tmp"
+	os.Remove(tmp)
+	if err := os.Symlink(target, tmp); err != nil {
+		ReportError(err)
+		return
+	}
+	if err := os.Rename(tmp, link); err != nil {
+		os.Remove(tmp)
+		ReportError(err)
+	}
+}
+
+// backupTimeFormat lumberjack备份文件名中的时间格式
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// mill 清理该路径写入的旧文件，current为当前写入的文件
+// 超过保留时间或保留数量的文件被删除，其余未压缩的文件在开启压缩时被压缩
+func (f *sharedFile) mill(current string, settings rotationSettings) {
+	f.millMu.Lock()
+	defer f.millMu.Unlock()
+
+	cutoff := time.Now().Add(-settings.maxAge)
+	for i, file := range f.oldFiles(current) {
+		expired := settings.maxAge > 0 && file.ModTime().Before(cutoff)
+		if expired || (settings.maxFiles > 0 && i >= settings.maxFiles) {
+			if err := os.Remove(file.path); err != nil && !os.IsNotExist(err) {
+				ReportError(err)
+			}
+			continue
+		}
+		if settings.compress && filepath.Ext(file.path) != ".gz" {
+			if err := compressFile(file.path); err != nil {
+				ReportError(err)
+			}
+		}
+	}
+}
+
+// oldFile 时间模式写入的一个旧文件
+type oldFile struct {
+	os.FileInfo
+	path string
+}
+
+// oldFiles 返回该路径写入过的除current外的所有文件，包括按大小轮转的备份和压缩后的文件，按修改时间从新到旧排序
+// 路径不含时间模式时只返回文件名带有lumberjack备份时间戳的文件，不会误删同目录下名称相近的其他日志
+func (f *sharedFile) oldFiles(current string) []oldFile {
+	pattern := filePatternGlob(f.path)
+	plain := !hasFilePattern(f.path)
+	seen := map[string]bool{fileKey(current): true}
+	var files []oldFile
+	for _, glob := range []string{pattern, pattern + ".gz", backupGlob(pattern), backupGlob(pattern) + ".gz"} {
+		matches, _ := filepath.Glob(glob)
+		for _, path := range matches {
+			key := fileKey(path)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if plain && !isBackupOf(f.path, path) {
+				continue
+			}
+			// 跳过指向当前文件的符号链接等非普通文件
+			info, err := os.Lstat(path)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			files = append(files, oldFile{FileInfo: info, path: path})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().After(files[j].ModTime())
+	})
+	return files
+}
+
+// isBackupOf 判断path是否为lumberjack轮转filename生成的备份文件（可能已压缩）
+func isBackupOf(filename, path string) bool {
+	ext := filepath.Ext(filename)
+	prefix := strings.TrimSuffix(filename, ext) + "-"
+	name := strings.TrimSuffix(path, ".gz")
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+		return false
+	}
+	_, err := time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext))
+	return err == nil
+}
+
+// compressFile 将文件压缩为同名的.gz文件并删除原文件，压缩后的文件保留原文件的修改时间
+func compressFile(path string) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			dst.Close()
+			os.Remove(path + ".gz")
+		}
+	}()
+	gz := gzip.NewWriter(dst)
+	if _, err = io.Copy(gz, src); err != nil {
+		return err
+	}
+	if err = gz.Close(); err != nil {
+		return err
+	}
+	if err = dst.Close(); err != nil {
+		return err
+	}
+	if err = os.Chtimes(path+".gz", info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	src.Close()
+	return os.Remove(path)
+}
+
+// write 写入共享文件，进入下一个周期时先按时间轮转，写入后超过大小时先按大小轮转
+func (f *sharedFile) write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	if !f.next.IsZero() {
+		if now := time.Now(); !now.Before(f.next) {
+			f.rotate(now)
+		}
+	}
+	if f.exceeds(int64(len(p))) {
+		f.rotateFile()
+	}
+	n, err := f.logger.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// exceeds 判断写入n字节后是否超过大小限制，判断方式与lumberjack相同，调用时需持有f.mu
+// 单条日志超过限制时不轮转，由lumberjack返回错误
+func (f *sharedFile) exceeds(n int64) bool {
+	max := f.maxBytes()
+	if n > max {
+		return false
+	}
+	if f.size < 0 {
+		// lumberjack打开已有文件时，写入后达到限制即轮转
+		f.size = 0
+		if info, err := os.Stat(f.logger.Filename); err == nil {
+			f.size = info.Size()
+			return f.size+n >= max
+		}
+	}
+	return f.size+n > max
+}
+
+// release 释放写入器对共享文件的引用，剩余的写入器使用一致的轮转配置时应用该配置；
+// 最后一个写入器释放时等待清理完成并关闭文件
+func (f *sharedFile) release(w *sharedFileWriter) error {
+	fileRegistryMu.Lock()
+	defer fileRegistryMu.Unlock()
+	delete(f.holders, w)
+	if len(f.holders) > 0 {
+		if settings, ok := f.agreed(); ok {
+			f.configure(settings)
+		}
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.milling.Wait()
+	f.closed = true
+	f.size = -1
+	return f.logger.Close()
+}
+
+// sharedFileWriter 日志输出持有的共享文件引用，关闭时只释放自己的引用
+type sharedFileWriter struct {
+	file   *sharedFile
+	once   sync.Once
+	closed atomic.Bool
+}
+
+// Write 实现io.Writer，写入共享文件，关闭后返回os.ErrClosed，不会重新打开文件
+func (w *sharedFileWriter) Write(p []byte) (int, error) {
+	if w.closed.Load() {
+		return 0, os.ErrClosed
+	}
+	return w.file.write(p)
+}
+
+// Close 实现io.Closer，释放该输出对共享文件的引用，多次调用只释放一次
+func (w *sharedFileWriter) Close() error {
+	var err error
+	w.once.Do(func() {
+		w.closed.Store(true)
+		err = w.file.release(w)
+	})
+	return err
+}
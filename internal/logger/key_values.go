@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BadKey 键值对中缺少值的键和不是字符串的键输出时使用的字段键，与log/slog相同
+const BadKey = "!BADKEY"
+
+// KeyValueFields 将Infow等方法交替传入的键和值转换为字段
+// 键必须是字符串，参数本身是Field时直接作为字段使用；末尾缺少值的键和不是字符串的键
+// 以BadKey为键输出，不会panic，并把问题交给错误处理函数
+func KeyValueFields(keysAndValues []interface{}) []Field {
+	if len(keysAndValues) == 0 {
+		return nil
+	}
+
+	fields := make([]Field, 0, (len(keysAndValues)+1)/2)
+	var problems []string
+	for i := 0; i < len(keysAndValues); {
+		switch key := keysAndValues[i].(type) {
+		case Field:
+			fields = append(fields, key)
+			i++
+		case string:
+			if i+1 == len(keysAndValues) {
+				fields = append(fields, String(BadKey, key))
+				problems = append(problems, fmt.Sprintf("key %q has no value", key))
+				i++
+				continue
+			}
+			fields = append(fields, keyValueField(key, keysAndValues[i+1]))
+			i += 2
+		default:
+			fields = append(fields, Any(BadKey, key))
+			problems = append(problems, fmt.Sprintf("key %v of type %T is not a string", key, key))
+			i++
+		}
+	}
+	if len(problems) > 0 {
+		ReportError(fmt.Errorf("%w: %s", ErrInvalidKeyValues, strings.Join(problems, "; ")))
+	}
+	return fields
+}
+
+// keyValueField 为常见类型的值创建类型化字段，其他值使用Any，错误值由提供者输出时展开
+func keyValueField(key string, value interface{}) Field {
+	switch v := value.(type) {
+	case string:
+		return String(key, v)
+	case int:
+		return Int(key, v)
+	case int64:
+		return Int64(key, v)
+	case float64:
+		return Float64(key, v)
+	case bool:
+		return Bool(key, v)
+	case time.Duration:
+		return Duration(key, v)
+	case time.Time:
+		return Time(key, v)
+	default:
+		return Any(key, value)
+	}
+}
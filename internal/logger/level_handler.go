@@ -0,0 +1,328 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LevelHandler 查看和修改日志级别的HTTP接口
+//
+//	GET  ?name=db                      查看所有日志实例或指定名称实例的级别
+//	PUT  ?name=db&level=debug&ttl=5m   修改级别，省略name时修改所有日志实例
+//
+// PUT的参数也可以通过JSON请求体传入：{"name": "db", "level": "debug", "ttl": "5m"}。
+// 指定name时通过SetNamedLevel修改，同样作用于Named创建的 "db.pool" 等后代日志实例。
+// 指定ttl时，到期后恢复修改前的级别，到期前已被其他方式改为别的级别的实例保持不变；
+// 到期前再次修改同一目标会取消之前的恢复，但仍然恢复到第一次临时修改之前的级别
+type LevelHandler struct {
+	factory *LogFactory
+
+	mu        sync.Mutex
+	overrides map[string]*levelOverride // 按名称记录等待恢复的临时修改，空名称表示所有实例
+}
+
+// levelOverride 一次带有过期时间的级别修改
+type levelOverride struct {
+	timer    *time.Timer
+	revertAt time.Time
+	level    LogLevel        // 这次修改设置的级别
+	explicit *LogLevel       // 按名称修改前级别注册表中该名称上设置的级别
+	previous []levelSnapshot // 修改前各实例的级别
+}
+
+// levelTarget 可以读取和设置级别的对象，即日志实例或登记在级别注册表中的级别
+type levelTarget interface {
+	GetLevel() LogLevel
+	SetLevel(level LogLevel)
+}
+
+// registeredLevel 将级别注册表中的AtomicLevel适配为levelTarget
+type registeredLevel struct {
+	*AtomicLevel
+}
+
+// GetLevel 实现levelTarget
+func (l registeredLevel) GetLevel() LogLevel {
+	return l.Level()
+}
+
+// levelSnapshot 修改前的级别
+type levelSnapshot struct {
+	target levelTarget
+	level  LogLevel
+}
+
+// original 返回目标在这次修改前的级别
+func (o *levelOverride) original(target levelTarget) (LogLevel, bool) {
+	for _, snapshot := range o.previous {
+		if snapshot.target == target {
+			return snapshot.level, true
+		}
+	}
+	return 0, false
+}
+
+// levelRequest PUT请求的参数
+type levelRequest struct {
+	Name  string `json:"name"`
+	Level string `json:"level"`
+	TTL   string `json:"ttl"`
+}
+
+// levelResponse 修改级别后的响应
+type levelResponse struct {
+	Loggers  []LoggerInfo `json:"loggers"`
+	RevertAt *time.Time   `json:"revertAt,omitempty"`
+}
+
+// NewLevelHandler 创建日志级别HTTP接口，factory为nil时使用全局日志工厂
+func NewLevelHandler(factory *LogFactory) *LevelHandler {
+	if factory == nil {
+		factory = GetLogFactory()
+	}
+	return &LevelHandler{
+		factory:   factory,
+		overrides: make(map[string]*levelOverride),
+	}
+}
+
+// 全局日志工厂的日志级别HTTP接口
+var (
+	levelHandler     *LevelHandler
+	levelHandlerOnce sync.Once
+)
+
+// GetLevelHandler 获取作用于全局日志工厂的日志级别HTTP接口，多次调用返回同一个实例，
+// 以便临时修改的恢复不受调用方式影响
+func GetLevelHandler() *LevelHandler {
+	levelHandlerOnce.Do(func() {
+		levelHandler = NewLevelHandler(GetLogFactory())
+	})
+	return levelHandler
+}
+
+// ServeHTTP 实现http.Handler
+func (h *LevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.get(w, r)
+	case http.MethodPut:
+		h.put(w, r)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		writeJSONError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	}
+}
+
+// get 返回日志实例的级别
+func (h *LevelHandler) get(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	infos := h.infos(name)
+	if name != "" && len(infos) == 0 {
+		writeJSONError(w, http.StatusNotFound, fmt.Errorf("logger %q not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, levelResponse{Loggers: infos})
+}
+
+// put 修改日志实例的级别
+func (h *LevelHandler) put(w http.ResponseWriter, r *http.Request) {
+	req, err := parseLevelRequest(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	level, err := ParseLevel(req.Level)
+	if err == nil && !level.valid() {
+		err = fmt.Errorf("unknown log level %q", req.Level)
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var ttl time.Duration
+	if req.TTL != "" {
+		if ttl, err = ParseDuration(req.TTL); err != nil || ttl <= 0 {
+			writeJSONError(w, http.StatusBadRequest, fmt.Errorf("invalid ttl %q", req.TTL))
+			return
+		}
+	}
+
+	targets := h.targets(req.Name)
+	if len(targets) == 0 {
+		writeJSONError(w, http.StatusNotFound, fmt.Errorf("logger %q not found", req.Name))
+		return
+	}
+
+	revertAt := h.setLevel(req.Name, targets, level, ttl)
+	writeJSON(w, http.StatusOK, levelResponse{Loggers: h.infos(req.Name), RevertAt: revertAt})
+}
+
+// targets 返回修改级别的目标：省略名称时为日志工厂创建的所有实例；
+// 否则为级别注册表中该名称及其后代的级别，以及没有登记级别的同名实例
+func (h *LevelHandler) targets(name string) []levelTarget {
+	var targets []levelTarget
+	if name != "" {
+		for _, level := range GetLevelRegistry().registered(name, true) {
+			targets = append(targets, registeredLevel{level})
+		}
+	}
+	for _, l := range h.factory.LoggersNamed(name) {
+		if _, ok := l.(AtomicLeveler); ok && name != "" {
+			continue
+		}
+		targets = append(targets, l)
+	}
+	return targets
+}
+
+// setLevel 修改级别，ttl大于0时安排到期恢复，返回恢复时间
+func (h *LevelHandler) setLevel(name string, targets []levelTarget, level LogLevel, ttl time.Duration) *time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// 取消同一目标上尚未到期的恢复，保留最初的级别
+	pending := h.overrides[name]
+	if pending != nil {
+		pending.timer.Stop()
+		delete(h.overrides, name)
+	}
+
+	if ttl <= 0 {
+		applyLevel(name, targets, level)
+		return nil
+	}
+
+	override := &levelOverride{
+		revertAt: time.Now().Add(ttl),
+		level:    level,
+		previous: make([]levelSnapshot, 0, len(targets)),
+	}
+	if pending != nil {
+		override.explicit = pending.explicit
+	} else if name != "" {
+		if explicit, ok := GetLevelRegistry().explicitLevel(name); ok {
+			override.explicit = &explicit
+		}
+	}
+	for _, target := range targets {
+		snapshot := levelSnapshot{target: target, level: target.GetLevel()}
+		if pending != nil {
+			if original, ok := pending.original(target); ok {
+				snapshot.level = original
+			}
+		}
+		override.previous = append(override.previous, snapshot)
+	}
+	applyLevel(name, targets, level)
+	override.timer = time.AfterFunc(ttl, func() {
+		h.revert(name, override)
+	})
+	h.overrides[name] = override
+
+	revertAt := override.revertAt
+	return &revertAt
+}
+
+// applyLevel 将级别设置到所有目标，指定名称时同时设置到级别注册表，之后创建的同名实例和后代也使用该级别
+func applyLevel(name string, targets []levelTarget, level LogLevel) {
+	if name != "" {
+		SetNamedLevel(name, level)
+	}
+	for _, target := range targets {
+		target.SetLevel(level)
+	}
+}
+
+// revert 到期后恢复修改前的级别
+func (h *LevelHandler) revert(name string, override *levelOverride) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.overrides[name] != override {
+		// 已经被新的修改取代
+		return
+	}
+	delete(h.overrides, name)
+
+	// 只恢复仍然是这次修改设置的级别，之后通过其他方式做的修改保持不变
+	if name != "" {
+		if current, ok := GetLevelRegistry().explicitLevel(name); ok && current == override.level {
+			if override.explicit != nil {
+				SetNamedLevel(name, *override.explicit)
+			} else {
+				UnsetNamedLevel(name)
+			}
+		}
+	}
+	for _, snapshot := range override.previous {
+		if snapshot.target.GetLevel() == override.level {
+			snapshot.target.SetLevel(snapshot.level)
+		}
+	}
+}
+
+// infos 返回所有或指定名称的日志实例信息，不是由日志工厂创建的同名实例（如通过Named创建）从级别注册表中查找
+func (h *LevelHandler) infos(name string) []LoggerInfo {
+	infos := h.factory.Loggers()
+	if name == "" {
+		return infos
+	}
+	for _, info := range infos {
+		if info.Name == name {
+			return []LoggerInfo{info}
+		}
+	}
+	if levels := GetLevelRegistry().registered(name, false); len(levels) > 0 {
+		return []LoggerInfo{{Name: name, Level: levels[0].Level()}}
+	}
+	return nil
+}
+
+// parseLevelRequest 从查询参数和JSON请求体中读取参数，请求体中的值优先
+func parseLevelRequest(r *http.Request) (levelRequest, error) {
+	query := r.URL.Query()
+	req := levelRequest{
+		Name:  query.Get("name"),
+		Level: query.Get("level"),
+		TTL:   query.Get("ttl"),
+	}
+
+	if r.Body != nil && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var body levelRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return req, fmt.Errorf("invalid request body: %w", err)
+		}
+		if body.Name != "" {
+			req.Name = body.Name
+		}
+		if body.Level != "" {
+			req.Level = body.Level
+		}
+		if body.TTL != "" {
+			req.TTL = body.TTL
+		}
+	}
+
+	if req.Level == "" {
+		return req, fmt.Errorf("level is required")
+	}
+	return req, nil
+}
+
+// writeJSON 输出JSON响应
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeJSONError 输出JSON格式的错误
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,203 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+	"weak"
+)
+
+// LevelRegistry 按点分名称管理日志级别的注册表
+// 为 "app.db" 设置的级别会应用到 "app.db" 以及 "app.db.pool" 等所有后代日志实例，
+// 除非后代自己或更近的祖先也设置了级别。注册表只弱引用日志实例的级别，不会阻止其被回收
+type LevelRegistry struct {
+	mu       sync.Mutex
+	explicit map[string]LogLevel                    // 通过SetLevel设置的级别
+	levels   map[string][]weak.Pointer[AtomicLevel] // 按名称登记的日志实例级别
+}
+
+// NewLevelRegistry 创建级别注册表
+func NewLevelRegistry() *LevelRegistry {
+	return &LevelRegistry{
+		explicit: make(map[string]LogLevel),
+		levels:   make(map[string][]weak.Pointer[AtomicLevel]),
+	}
+}
+
+// 全局级别注册表
+var (
+	levelRegistry     *LevelRegistry
+	levelRegistryOnce sync.Once
+)
+
+// GetLevelRegistry 获取全局级别注册表，内置的日志实例都登记在其中
+func GetLevelRegistry() *LevelRegistry {
+	levelRegistryOnce.Do(func() {
+		levelRegistry = NewLevelRegistry()
+	})
+	return levelRegistry
+}
+
+// JoinLoggerName 拼接父子日志名称，如 "app" 和 "db" 得到 "app.db"
+func JoinLoggerName(parent string, name string) string {
+	switch {
+	case parent == "":
+		return name
+	case name == "":
+		return parent
+	default:
+		return parent + "." + name
+	}
+}
+
+// isDescendant 判断name是否为ancestor自身或其后代，空名称是所有日志实例的祖先
+func isDescendant(name string, ancestor string) bool {
+	return ancestor == "" || name == ancestor || strings.HasPrefix(name, ancestor+".")
+}
+
+// Register 登记日志实例的级别，如果名称或其祖先已经设置了级别，立即应用该级别
+func (r *LevelRegistry) Register(name string, level *AtomicLevel) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 顺便清理已被回收的级别
+	alive := r.levels[name][:0]
+	for _, p := range r.levels[name] {
+		if p.Value() != nil {
+			alive = append(alive, p)
+		}
+	}
+	r.levels[name] = append(alive, weak.Make(level))
+
+	if effective, ok := r.effective(name); ok {
+		level.SetLevel(effective)
+	}
+}
+
+// SetLevel 为名称及其所有后代设置级别，空名称表示所有日志实例
+func (r *LevelRegistry) SetLevel(name string, level LogLevel) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.explicit[name] = level
+	r.propagate(name)
+}
+
+// UnsetLevel 取消名称上设置的级别，后代改为使用更远祖先上设置的级别；
+// 没有祖先设置级别的日志实例保持当前级别不变
+func (r *LevelRegistry) UnsetLevel(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.explicit, name)
+	r.propagate(name)
+}
+
+// Level 返回名称的有效级别，即名称自身或最近的祖先上设置的级别
+func (r *LevelRegistry) Level(name string) (LogLevel, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.effective(name)
+}
+
+// Levels 返回所有通过SetLevel设置的级别
+func (r *LevelRegistry) Levels() map[string]LogLevel {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	levels := make(map[string]LogLevel, len(r.explicit))
+	for name, level := range r.explicit {
+		levels[name] = level
+	}
+	return levels
+}
+
+// registered 返回名称登记的仍未被回收的级别，descendants为true时同时返回所有后代的级别
+func (r *LevelRegistry) registered(name string, descendants bool) []*AtomicLevel {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var levels []*AtomicLevel
+	for registered, pointers := range r.levels {
+		if registered != name && !(descendants && isDescendant(registered, name)) {
+			continue
+		}
+		for _, p := range pointers {
+			if level := p.Value(); level != nil {
+				levels = append(levels, level)
+			}
+		}
+	}
+	return levels
+}
+
+// explicitLevel 返回名称自身通过SetLevel设置的级别
+func (r *LevelRegistry) explicitLevel(name string) (LogLevel, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	level, ok := r.explicit[name]
+	return level, ok
+}
+
+// effective 查找名称自身或最近的祖先上设置的级别，调用方需持有锁
+func (r *LevelRegistry) effective(name string) (LogLevel, bool) {
+	for {
+		if level, ok := r.explicit[name]; ok {
+			return level, true
+		}
+		if name == "" {
+			return 0, false
+		}
+		idx := strings.LastIndexByte(name, '.')
+		if idx < 0 {
+			name = ""
+		} else {
+			name = name[:idx]
+		}
+	}
+}
+
+// propagate 将有效级别应用到ancestor及其所有后代，并清理已被回收的级别，调用方需持有锁
+func (r *LevelRegistry) propagate(ancestor string) {
+	for name, pointers := range r.levels {
+		if !isDescendant(name, ancestor) {
+			continue
+		}
+		effective, ok := r.effective(name)
+		alive := pointers[:0]
+		for _, p := range pointers {
+			if level := p.Value(); level != nil {
+				if ok {
+					level.SetLevel(effective)
+				}
+				alive = append(alive, p)
+			}
+		}
+		if len(alive) == 0 {
+			delete(r.levels, name)
+		} else {
+			r.levels[name] = alive
+		}
+	}
+}
+
+// NewLoggerLevel 返回按选项创建的日志实例级别，并登记到全局级别注册表
+// 供日志提供者在创建日志实例时使用
+func NewLoggerLevel(name string, options *LoggerOptions) *AtomicLevel {
+	level := options.GetAtomicLevel()
+	GetLevelRegistry().Register(name, level)
+	return level
+}
+
+// NewNamedLevel 为子日志实例创建级别，继承父实例当前的级别并登记到全局级别注册表
+// 供日志提供者实现Named方法时使用
+func NewNamedLevel(name string, parent *AtomicLevel) *AtomicLevel {
+	level := NewAtomicLevel(parent.Level())
+	GetLevelRegistry().Register(name, level)
+	return level
+}
+
+// SetNamedLevel 为名称及其所有后代日志实例设置级别，如 "app.db"
+func SetNamedLevel(name string, level LogLevel) {
+	GetLevelRegistry().SetLevel(name, level)
+}
+
+// UnsetNamedLevel 取消名称上设置的级别
+func UnsetNamedLevel(name string) {
+	GetLevelRegistry().UnsetLevel(name)
+}
@@ -1,16 +1,44 @@
 package logger
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
 	"sync"
+	"time"
 )
 
 // LogFactory 日志工厂
 type LogFactory struct {
 	providers       map[string]LoggerProvider
 	defaultProvider string
+	config          *LoggingConfig    // 按名称定义的日志配置
+	named           map[string]Logger // CreateLogger按名称创建的实例
+	tracked         []trackedLogger   // 日志工厂创建过的所有未关闭的实例
+	pruneAt         int               // tracked达到该长度时清理已关闭的实例
+	strict          bool              // 严格模式，拒绝非法配置和未注册的提供者
 	mu              sync.RWMutex
 }
 
+// trackedLogger 日志工厂创建过的日志实例
+// 派生出的实例与原实例共享级别和输出，因此原实例不再被引用时仍需持有，直到调用Close
+type trackedLogger struct {
+	name     string
+	provider string
+	logger   Logger
+	managed  bool // 是否由配置文档管理，即通过CreateLogger创建
+}
+
+// isClosed 判断日志实例是否已关闭，没有Closed方法的实例视为未关闭
+func isClosed(logger Logger) bool {
+	closer, ok := logger.(interface{ Closed() bool })
+	return ok && closer.Closed()
+}
+
 // 全局日志工厂实例
 var (
 	factory     *LogFactory
@@ -35,6 +63,7 @@ func NewLogFactory() *LogFactory {
 	return &LogFactory{
 		providers:       make(map[string]LoggerProvider),
 		defaultProvider: "console",
+		named:           make(map[string]Logger),
 	}
 }
 
@@ -74,77 +103,450 @@ func (f *LogFactory) GetProvider(name string) (LoggerProvider, bool) {
 	return provider, exists
 }
 
+// SetStrict 设置严格模式
+// 严格模式下非法的配置不会被修正，未注册的提供者也不会回退到默认提供者：
+// Create系列方法会panic，重新加载配置时非法的定义会返回错误而不会被应用
+func (f *LogFactory) SetStrict(strict bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.strict = strict
+}
+
+// IsStrict 是否为严格模式
+func (f *LogFactory) IsStrict() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.strict
+}
+
+// Configure 设置按名称定义的日志配置
+// 之后通过CreateLogger创建的日志实例将使用文档中同名的定义，没有定义时使用文档的默认配置；
+// 已经创建的日志实例也会按新的定义重新配置，失败时交给错误处理函数
+func (f *LogFactory) Configure(config *LoggingConfig) {
+	ReportError(f.Reload(config))
+}
+
+// GetLoggingConfig 获取当前的日志配置文档，未配置时返回nil
+func (f *LogFactory) GetLoggingConfig() *LoggingConfig {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.config
+}
+
+// Reload 替换配置文档，并将新的级别、格式和输出应用到已经创建的日志实例
+// 通过CreateLogger创建的实例使用文档中同名的定义或默认配置，其他实例只在文档中有同名定义时才会更新。
+// 已有实例无法更换提供者，提供者的变化只对之后创建的实例生效
+func (f *LogFactory) Reload(config *LoggingConfig) error {
+	f.mu.Lock()
+	f.config = config
+	strict := f.strict
+	if config == nil {
+		f.mu.Unlock()
+		return nil
+	}
+
+	loggers := f.live()
+	updates := make([]trackedLogger, 0, len(loggers))
+	definitions := make([]*LogConfig, 0, len(loggers))
+	for _, t := range loggers {
+		var definition *LogConfig
+		if t.managed {
+			definition = config.Lookup(t.name)
+			if definition.Provider == "" {
+				definition.Provider = f.defaultProvider
+			}
+			// 提供者发生变化时，让之后的CreateLogger重新创建实例
+			if definition.Provider != t.provider && f.named[t.name] == t.logger {
+				delete(f.named, t.name)
+			}
+		} else if definition = config.Find(t.name); definition == nil {
+			continue
+		}
+		updates = append(updates, t)
+		definitions = append(definitions, definition)
+	}
+	f.mu.Unlock()
+
+	var errs []error
+	for i, t := range updates {
+		if err := reconfigureLogger(t.logger, definitions[i], strict); err != nil {
+			errs = append(errs, fmt.Errorf("reload logger %q: %w", t.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ApplyLogConfig 将配置应用到所有同名的日志实例，并更新配置文档中的同名定义
+func (f *LogFactory) ApplyLogConfig(config *LogConfig) error {
+	f.mu.Lock()
+	strict := f.strict
+	if f.config != nil {
+		f.config = f.config.withDefinition(config)
+	}
+	targets := make([]Logger, 0)
+	for _, t := range f.live() {
+		if t.name == config.Name {
+			targets = append(targets, t.logger)
+		}
+	}
+	f.mu.Unlock()
+
+	var errs []error
+	for _, target := range targets {
+		if err := reconfigureLogger(target, config.Clone(), strict); err != nil {
+			errs = append(errs, fmt.Errorf("apply config to logger %q: %w", config.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reconfigureLogger 将配置应用到已有的日志实例，环境变量中的覆盖项优先
+// 严格模式下配置非法时不会应用；不支持Reconfigurable的实例只更新日志级别
+func reconfigureLogger(logger Logger, config *LogConfig, strict bool) error {
+	if err := config.ApplyEnv(); err != nil {
+		return err
+	}
+	if strict {
+		if err := config.ValidateStrict(); err != nil {
+			return err
+		}
+	}
+	config.Validate()
+	if r, ok := logger.(Reconfigurable); ok {
+		return r.Reconfigure(config.ToOptions()...)
+	}
+	logger.SetLevel(config.Level)
+	return nil
+}
+
+// minPruneAt 清理已关闭实例的最小长度
+const minPruneAt = 64
+
+// track 记录日志工厂创建的日志实例，以便重新加载配置、刷新和关闭时使用
+// 记录的数量翻倍时清理一次已关闭的实例，避免记录无限增长
+func (f *LogFactory) track(name string, provider string, logger Logger, managed bool) Logger {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.tracked) >= f.pruneAt {
+		f.live()
+		f.pruneAt = max(2*len(f.tracked), minPruneAt)
+	}
+	f.tracked = append(f.tracked, trackedLogger{
+		name:     name,
+		provider: provider,
+		logger:   logger,
+		managed:  managed,
+	})
+	return logger
+}
+
+// live 返回未关闭的日志实例，并移除已关闭的实例，调用时需持有f.mu的写锁
+// 已关闭的按名称创建的实例同时从named中移除，之后的CreateLogger会重新创建
+func (f *LogFactory) live() []trackedLogger {
+	alive := f.tracked[:0]
+	for _, t := range f.tracked {
+		if !isClosed(t.logger) {
+			alive = append(alive, t)
+		} else if t.managed && f.named[t.name] == t.logger {
+			delete(f.named, t.name)
+		}
+	}
+	clear(f.tracked[len(alive):])
+	f.tracked = alive
+	return slices.Clone(alive)
+}
+
+// LoggerInfo 日志工厂创建过的日志实例的信息
+type LoggerInfo struct {
+	Name     string   `json:"name"`     // 日志名称
+	Provider string   `json:"provider"` // 日志提供者名称
+	Level    LogLevel `json:"level"`    // 当前日志级别
+}
+
+// Loggers 返回日志工厂创建过的所有未关闭的日志实例的信息，按名称排序
+// 同名的实例只返回最后创建的一个
+func (f *LogFactory) Loggers() []LoggerInfo {
+	f.mu.Lock()
+	loggers := f.live()
+	f.mu.Unlock()
+
+	latest := make(map[string]trackedLogger, len(loggers))
+	for _, t := range loggers {
+		latest[t.name] = t
+	}
+
+	infos := make([]LoggerInfo, 0, len(latest))
+	for _, t := range latest {
+		infos = append(infos, LoggerInfo{Name: t.name, Provider: t.provider, Level: t.logger.GetLevel()})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// LoggersNamed 返回日志工厂创建过的所有未关闭的指定名称的日志实例，name为空时返回全部实例
+func (f *LogFactory) LoggersNamed(name string) []Logger {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	loggers := make([]Logger, 0)
+	for _, t := range f.live() {
+		if name == "" || t.name == name {
+			loggers = append(loggers, t.logger)
+		}
+	}
+	return loggers
+}
+
+// Flush 刷新日志工厂创建的所有日志实例，ctx结束时不再等待并返回ctx的错误
+func (f *LogFactory) Flush(ctx context.Context) error {
+	f.mu.Lock()
+	loggers := f.live()
+	f.mu.Unlock()
+	return each(ctx, "flush", loggers, Logger.Sync, nil)
+}
+
+// Shutdown 刷新并关闭日志工厂创建的所有日志实例的输出，再关闭实现了io.Closer的日志提供者
+// ctx结束时不再等待并返回ctx的错误，未完成的关闭在后台继续进行；关闭后写入文件的日志会被丢弃，
+// 日志工厂也不再跟踪这些实例，之后的CreateLogger会重新创建实例
+func (f *LogFactory) Shutdown(ctx context.Context) error {
+	f.mu.Lock()
+	loggers := f.live()
+	f.tracked = nil
+	clear(f.named)
+	closers := make([]io.Closer, 0)
+	for _, provider := range f.providers {
+		if closer, ok := provider.(io.Closer); ok {
+			closers = append(closers, closer)
+		}
+	}
+	f.mu.Unlock()
+
+	return each(ctx, "shutdown", loggers, Logger.Close, func() error {
+		var errs []error
+		for _, closer := range closers {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// each 并发地对loggers调用fn，全部完成后调用then，ctx先结束时返回ctx的错误
+func each(ctx context.Context, op string, loggers []trackedLogger, fn func(Logger) error, then func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		var (
+			mu   sync.Mutex
+			errs []error
+			wg   sync.WaitGroup
+		)
+		for _, t := range loggers {
+			wg.Add(1)
+			go func(t trackedLogger) {
+				defer wg.Done()
+				if err := fn(t.logger); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("%s logger %q: %w", op, t.name, err))
+					mu.Unlock()
+				}
+			}(t)
+		}
+		wg.Wait()
+		if then != nil {
+			if err := then(); err != nil {
+				errs = append(errs, fmt.Errorf("%s providers: %w", op, err))
+			}
+		}
+		done <- errors.Join(errs...)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%s loggers: %w", op, ctx.Err())
+	}
+}
+
 // CreateLogger 创建日志实例
+// 同一名称只会创建一次，实例关闭后再次调用时重新创建；如果已通过Configure设置了配置文档，则按名称使用对应的定义
+// 严格模式下定义非法或提供者未注册时会panic
 func (f *LogFactory) CreateLogger(name string) Logger {
-	return f.CreateLoggerWithProvider(name, f.defaultProvider)
+	f.mu.RLock()
+	config := f.config
+	defaultProvider := f.defaultProvider
+	strict := f.strict
+	logger, exists := f.named[name]
+	f.mu.RUnlock()
+
+	if exists && !isClosed(logger) {
+		return logger
+	}
+
+	var err error
+	provider := defaultProvider
+	if config == nil {
+		logger, err = f.buildWithProvider(name, defaultProvider, strict)
+	} else {
+		definition := config.Lookup(name)
+		if definition.Provider == "" {
+			definition.Provider = defaultProvider
+		}
+		provider = definition.Provider
+		logger, err = f.buildWithLogConfig(definition, strict)
+	}
+	mustBuild(err)
+
+	f.mu.Lock()
+	if existing, exists := f.named[name]; exists && !isClosed(existing) {
+		// 其他goroutine已经创建了同名实例
+		f.mu.Unlock()
+		return existing
+	}
+	f.named[name] = logger
+	f.mu.Unlock()
+
+	return f.track(name, provider, logger, true)
 }
 
 // CreateLoggerWithProvider 使用指定的提供者创建日志实例
+// 提供者未注册时使用默认提供者，严格模式下会panic
 func (f *LogFactory) CreateLoggerWithProvider(name string, providerName string, opts ...Option) Logger {
-	f.mu.RLock()
-	provider, exists := f.providers[providerName]
-	f.mu.RUnlock()
+	logger, err := f.buildWithProvider(name, providerName, f.IsStrict(), opts...)
+	mustBuild(err)
+	return f.track(name, providerName, logger, false)
+}
+
+// BuildLoggerWithProvider 使用指定的提供者创建日志实例
+// 与CreateLoggerWithProvider不同，总是严格验证，提供者未注册或选项非法时返回错误
+func (f *LogFactory) BuildLoggerWithProvider(name string, providerName string, opts ...Option) (Logger, error) {
+	logger, err := f.buildWithProvider(name, providerName, true, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return f.track(name, providerName, logger, false), nil
+}
+
+// buildWithProvider 使用指定的提供者创建日志实例，环境变量中的覆盖项追加在opts之后
+func (f *LogFactory) buildWithProvider(name string, providerName string, strict bool, opts ...Option) (Logger, error) {
+	envOpts, err := EnvOptions(name)
+	if err := check(err, strict); err != nil {
+		return nil, err
+	}
+	opts = append(opts[:len(opts):len(opts)], envOpts...)
 
-	if !exists {
-		// 如果指定的提供者不存在，使用默认提供者
-		f.mu.RLock()
-		provider, exists = f.providers[f.defaultProvider]
-		f.mu.RUnlock()
-		if !exists {
-			// 如果默认提供者也不存在，使用控制台日志
-			return NewConsoleLogger(name, opts...)
+	if strict {
+		if err := configFromOptions(name, providerName, NewLoggerOptions(opts...)).ValidateStrict(); err != nil {
+			return nil, fmt.Errorf("logger %q: %w", name, err)
 		}
 	}
 
-	return provider.Create(name, opts...)
+	provider, err := f.lookupProvider(providerName, strict)
+	if err != nil {
+		return nil, fmt.Errorf("logger %q: %w", name, err)
+	}
+	if provider == nil {
+		// 如果默认提供者也不存在，使用控制台日志
+		return NewConsoleLogger(name, opts...), nil
+	}
+	return provider.Create(name, opts...), nil
 }
 
-// CreateLoggerWithMap 根据配置创建日志实例
+// CreateLoggerWithMap 根据配置创建日志实例，环境变量中的覆盖项优先于config
+// 提供者未注册时使用默认提供者，严格模式下会panic
 func (f *LogFactory) CreateLoggerWithMap(name string, config map[string]interface{}) Logger {
+	logger, providerName, err := f.buildWithMap(name, config, f.IsStrict())
+	mustBuild(err)
+	return f.track(name, providerName, logger, false)
+}
+
+// BuildLoggerWithMap 根据配置创建日志实例
+// 与CreateLoggerWithMap不同，总是严格验证，配置值无法转换、非法或提供者未注册时返回错误
+func (f *LogFactory) BuildLoggerWithMap(name string, config map[string]interface{}) (Logger, error) {
+	logger, providerName, err := f.buildWithMap(name, config, true)
+	if err != nil {
+		return nil, err
+	}
+	return f.track(name, providerName, logger, false), nil
+}
+
+// buildWithMap 根据配置map创建日志实例，返回实例和提供者名称
+func (f *LogFactory) buildWithMap(name string, config map[string]interface{}, strict bool) (Logger, string, error) {
+	config, err := applyEnvToMap(name, config)
+	if err := check(err, strict); err != nil {
+		return nil, "", err
+	}
+
 	// 从配置中获取提供者名称
-	providerName := f.defaultProvider
+	providerName := f.GetDefaultProvider()
 	if pn, ok := config["provider"].(string); ok {
 		providerName = pn
 	}
 
-	f.mu.RLock()
-	provider, exists := f.providers[providerName]
-	f.mu.RUnlock()
-
-	if !exists {
-		// 如果指定的提供者不存在，使用默认提供者
-		f.mu.RLock()
-		provider, exists = f.providers[f.defaultProvider]
-		f.mu.RUnlock()
-		if !exists {
-			// 如果默认提供者也不存在，使用控制台日志
-			return NewConsoleLogger(name)
+	if strict {
+		opts, err := DecodeConfigMap(config)
+		if err == nil {
+			err = configFromOptions(name, providerName, NewLoggerOptions(opts...)).ValidateStrict()
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("logger %q: %w", name, err)
 		}
 	}
 
-	return provider.CreateWithConfig(name, config)
+	provider, err := f.lookupProvider(providerName, strict)
+	if err != nil {
+		return nil, "", fmt.Errorf("logger %q: %w", name, err)
+	}
+	if provider == nil {
+		// 如果默认提供者也不存在，使用控制台日志
+		return NewConsoleLogger(name), providerName, nil
+	}
+	return provider.CreateWithConfig(name, config), providerName, nil
 }
 
-// CreateLoggerWithLogConfig 根据LogConfig创建日志实例
+// CreateLoggerWithLogConfig 根据LogConfig创建日志实例，环境变量中的覆盖项优先于config
+// 非法的配置项会被修正为默认值，提供者未注册时使用默认提供者；严格模式下会panic
 func (f *LogFactory) CreateLoggerWithLogConfig(config *LogConfig) Logger {
-	// 验证配置
-	config.Validate()
+	logger, err := f.buildWithLogConfig(config, f.IsStrict())
+	mustBuild(err)
+	return f.track(config.Name, config.Provider, logger, false)
+}
 
-	// 获取提供者
-	f.mu.RLock()
-	provider, exists := f.providers[config.Provider]
-	f.mu.RUnlock()
+// BuildLoggerWithLogConfig 根据LogConfig创建日志实例
+// 与CreateLoggerWithLogConfig不同，总是严格验证，配置非法或提供者未注册时返回所有错误
+func (f *LogFactory) BuildLoggerWithLogConfig(config *LogConfig) (Logger, error) {
+	logger, err := f.buildWithLogConfig(config, true)
+	if err != nil {
+		return nil, err
+	}
+	return f.track(config.Name, config.Provider, logger, false), nil
+}
 
-	if !exists {
-		// 如果指定的提供者不存在，使用默认提供者
-		f.mu.RLock()
-		provider, exists = f.providers[f.defaultProvider]
-		f.mu.RUnlock()
-		if !exists {
-			// 如果默认提供者也不存在，使用控制台日志
-			return NewConsoleLogger(config.Name, config.ToOptions()...)
+// buildWithLogConfig 根据LogConfig创建日志实例，不会修改传入的config
+func (f *LogFactory) buildWithLogConfig(config *LogConfig, strict bool) (Logger, error) {
+	// 叠加环境变量并验证配置
+	config = config.Clone()
+	if err := check(config.ApplyEnv(), strict); err != nil {
+		return nil, err
+	}
+	if strict {
+		if err := config.ValidateStrict(); err != nil {
+			return nil, fmt.Errorf("logger %q: %w", config.Name, err)
 		}
 	}
+	config.Validate()
+
+	// 获取提供者
+	provider, err := f.lookupProvider(config.Provider, strict)
+	if err != nil {
+		return nil, fmt.Errorf("logger %q: %w", config.Name, err)
+	}
+	if provider == nil {
+		// 如果默认提供者也不存在，使用控制台日志
+		return NewConsoleLogger(config.Name, config.ToOptions()...), nil
+	}
 
 	// 创建配置map
 	configMap := make(map[string]interface{})
@@ -152,18 +554,71 @@ func (f *LogFactory) CreateLoggerWithLogConfig(config *LogConfig) Logger {
 	configMap["level"] = config.Level
 	configMap["format"] = config.Format
 	configMap["outputPath"] = config.OutputPath
+	if len(config.Outputs) > 0 {
+		configMap["outputs"] = config.Outputs
+	}
 	configMap["maxLogSize"] = config.MaxLogSize
 	configMap["maxLogAge"] = config.MaxLogAge
 	configMap["maxLogFiles"] = config.MaxLogFiles
 	configMap["compressLogs"] = config.CompressLogs
+	if config.RotateInterval > 0 {
+		configMap["rotateInterval"] = config.RotateInterval
+	}
+	if config.CurrentLink != "" {
+		configMap["currentLink"] = config.CurrentLink
+	}
 	configMap["maxMessageSize"] = config.MaxMessageSize
+	configMap["caller"] = config.Caller
+	configMap["callerSkip"] = config.CallerSkip
+	if config.StackLevel != nil {
+		configMap["stackLevel"] = *config.StackLevel
+	}
+	if config.AsyncQueueSize > 0 {
+		configMap["asyncQueueSize"] = config.AsyncQueueSize
+	}
+	if config.OverflowPolicy != "" {
+		configMap["overflowPolicy"] = config.OverflowPolicy
+	}
+	if config.OverflowLevel != nil {
+		configMap["overflowLevel"] = *config.OverflowLevel
+	}
 
 	// 添加额外配置
 	for k, v := range config.ExtraConfig {
 		configMap[k] = v
 	}
 
-	return provider.CreateWithConfig(config.Name, configMap)
+	return provider.CreateWithConfig(config.Name, configMap), nil
+}
+
+// lookupProvider 查找日志提供者
+// 非严格模式下提供者不存在时回退到默认提供者，默认提供者也不存在时返回nil；严格模式下返回ErrUnknownProvider
+func (f *LogFactory) lookupProvider(name string, strict bool) (LoggerProvider, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if provider, exists := f.providers[name]; exists {
+		return provider, nil
+	}
+	if strict {
+		return nil, fmt.Errorf("%w %q", ErrUnknownProvider, name)
+	}
+	return f.providers[f.defaultProvider], nil
+}
+
+// check 严格模式下返回err，否则将err交给错误处理函数后继续
+func check(err error, strict bool) error {
+	if strict {
+		return err
+	}
+	ReportError(err)
+	return nil
+}
+
+// mustBuild 严格模式下无法创建日志实例时panic
+func mustBuild(err error) {
+	if err != nil {
+		panic(err)
+	}
 }
 
 // 全局日志实例
@@ -173,8 +628,16 @@ var (
 )
 
 // GetLogger 获取全局日志实例
+// 全局日志实例名称为global，首次创建时同样会应用LANDC_LOG_*环境变量；
+// 严格模式下global的定义非法时首次调用会panic，之后的调用返回默认配置的控制台实例
 func GetLogger() Logger {
 	loggerOnce.Do(func() {
+		// CreateLogger panic时Once同样视为已完成，先设置后备实例，避免recover后全局实例为nil
+		defer func() {
+			if globalLogger == nil {
+				globalLogger = NewConsoleLogger("global")
+			}
+		}()
 		globalLogger = GetLogFactory().CreateLogger("global")
 	})
 	return globalLogger
@@ -185,6 +648,41 @@ func GetLoggerWithName(name string) Logger {
 	return GetLogFactory().CreateLogger(name)
 }
 
+// Configure 使用配置文档配置全局日志工厂
+func Configure(config *LoggingConfig) {
+	GetLogFactory().Configure(config)
+}
+
+// ReloadConfig 使用新的配置文档重新配置全局日志工厂中已有的日志实例
+func ReloadConfig(config *LoggingConfig) error {
+	return GetLogFactory().Reload(config)
+}
+
+// ApplyLogConfig 将配置应用到全局日志工厂中所有同名的日志实例
+func ApplyLogConfig(config *LogConfig) error {
+	return GetLogFactory().ApplyLogConfig(config)
+}
+
+// SetStrict 设置全局日志工厂的严格模式
+func SetStrict(strict bool) {
+	GetLogFactory().SetStrict(strict)
+}
+
+// BuildLoggerWithProvider 使用指定的提供者创建日志实例，选项非法或提供者未注册时返回错误
+func BuildLoggerWithProvider(name string, provider string, opts ...Option) (Logger, error) {
+	return GetLogFactory().BuildLoggerWithProvider(name, provider, opts...)
+}
+
+// BuildLoggerWithMap 根据配置创建日志实例，配置值非法或提供者未注册时返回错误
+func BuildLoggerWithMap(name string, config map[string]interface{}) (Logger, error) {
+	return GetLogFactory().BuildLoggerWithMap(name, config)
+}
+
+// BuildLoggerWithLogConfig 根据LogConfig创建日志实例，配置非法或提供者未注册时返回错误
+func BuildLoggerWithLogConfig(config *LogConfig) (Logger, error) {
+	return GetLogFactory().BuildLoggerWithLogConfig(config)
+}
+
 // GetLoggerWithProvider 获取指定提供者的日志实例
 func GetLoggerWithProvider(name string, provider string, opts ...Option) Logger {
 	return GetLogFactory().CreateLoggerWithProvider(name, provider, opts...)
@@ -205,6 +703,40 @@ func SetGlobalLogger(logger Logger) {
 	globalLogger = logger
 }
 
+// Shutdown 刷新并关闭全局日志工厂创建的所有日志实例，通常在程序退出前调用
+func Shutdown(ctx context.Context) error {
+	return GetLogFactory().Shutdown(ctx)
+}
+
+// exitFlushTimeout 退出程序前等待刷新日志的最长时间
+const exitFlushTimeout = 5 * time.Second
+
+// Exit 刷新全局日志工厂创建的所有日志实例后以code退出程序，最多等待5秒
+// 日志实例写入致命级日志后先刷新自己的输出，再调用Exit退出
+func Exit(code int) {
+	ctx, cancel := context.WithTimeout(context.Background(), exitFlushTimeout)
+	if err := GetLogFactory().Flush(ctx); err != nil {
+		ReportError(err)
+	}
+	cancel()
+	os.Exit(code)
+}
+
+// Trace 全局跟踪级日志
+func Trace(msg string, fields ...Field) {
+	GetLogger().Trace(msg, fields...)
+}
+
+// Tracef 全局格式化跟踪级日志
+func Tracef(format string, args ...interface{}) {
+	GetLogger().Tracef(format, args...)
+}
+
+// Tracew 全局跟踪级日志，keysAndValues为交替出现的键和值
+func Tracew(msg string, keysAndValues ...interface{}) {
+	GetLogger().Tracew(msg, keysAndValues...)
+}
+
 // Debug 全局调试级日志
 func Debug(msg string, fields ...Field) {
 	GetLogger().Debug(msg, fields...)
@@ -215,6 +747,11 @@ func Debugf(format string, args ...interface{}) {
 	GetLogger().Debugf(format, args...)
 }
 
+// Debugw 全局调试级日志，keysAndValues为交替出现的键和值
+func Debugw(msg string, keysAndValues ...interface{}) {
+	GetLogger().Debugw(msg, keysAndValues...)
+}
+
 // Info 全局信息级日志
 func Info(msg string, fields ...Field) {
 	GetLogger().Info(msg, fields...)
@@ -225,6 +762,11 @@ func Infof(format string, args ...interface{}) {
 	GetLogger().Infof(format, args...)
 }
 
+// Infow 全局信息级日志，keysAndValues为交替出现的键和值
+func Infow(msg string, keysAndValues ...interface{}) {
+	GetLogger().Infow(msg, keysAndValues...)
+}
+
 // Warn 全局警告级日志
 func Warn(msg string, fields ...Field) {
 	GetLogger().Warn(msg, fields...)
@@ -235,6 +777,11 @@ func Warnf(format string, args ...interface{}) {
 	GetLogger().Warnf(format, args...)
 }
 
+// Warnw 全局警告级日志，keysAndValues为交替出现的键和值
+func Warnw(msg string, keysAndValues ...interface{}) {
+	GetLogger().Warnw(msg, keysAndValues...)
+}
+
 // Error 全局错误级日志
 func Error(msg string, fields ...Field) {
 	GetLogger().Error(msg, fields...)
@@ -245,6 +792,11 @@ func Errorf(format string, args ...interface{}) {
 	GetLogger().Errorf(format, args...)
 }
 
+// Errorw 全局错误级日志，keysAndValues为交替出现的键和值
+func Errorw(msg string, keysAndValues ...interface{}) {
+	GetLogger().Errorw(msg, keysAndValues...)
+}
+
 // Fatal 全局致命级日志
 func Fatal(msg string, fields ...Field) {
 	GetLogger().Fatal(msg, fields...)
@@ -255,6 +807,11 @@ func Fatalf(format string, args ...interface{}) {
 	GetLogger().Fatalf(format, args...)
 }
 
+// Fatalw 全局致命级日志，keysAndValues为交替出现的键和值
+func Fatalw(msg string, keysAndValues ...interface{}) {
+	GetLogger().Fatalw(msg, keysAndValues...)
+}
+
 // Panic 全局恐慌级日志
 func Panic(msg string, fields ...Field) {
 	GetLogger().Panic(msg, fields...)
@@ -264,3 +821,53 @@ func Panic(msg string, fields ...Field) {
 func Panicf(format string, args ...interface{}) {
 	GetLogger().Panicf(format, args...)
 }
+
+// Panicw 全局恐慌级日志，keysAndValues为交替出现的键和值
+func Panicw(msg string, keysAndValues ...interface{}) {
+	GetLogger().Panicw(msg, keysAndValues...)
+}
+
+// Log 全局按指定级别输出日志
+func Log(level LogLevel, msg string, fields ...Field) {
+	GetLogger().Log(level, msg, fields...)
+}
+
+// Logf 全局按指定级别输出格式化日志
+func Logf(level LogLevel, format string, args ...interface{}) {
+	GetLogger().Logf(level, format, args...)
+}
+
+// Logw 全局按指定级别输出日志，keysAndValues为交替出现的键和值
+func Logw(level LogLevel, msg string, keysAndValues ...interface{}) {
+	GetLogger().Logw(level, msg, keysAndValues...)
+}
+
+// TraceContext 全局跟踪级日志，并输出从ctx中提取的字段
+func TraceContext(ctx context.Context, msg string, fields ...Field) {
+	GetLogger().TraceContext(ctx, msg, fields...)
+}
+
+// DebugContext 全局调试级日志，并输出从ctx中提取的字段
+func DebugContext(ctx context.Context, msg string, fields ...Field) {
+	GetLogger().DebugContext(ctx, msg, fields...)
+}
+
+// InfoContext 全局信息级日志，并输出从ctx中提取的字段
+func InfoContext(ctx context.Context, msg string, fields ...Field) {
+	GetLogger().InfoContext(ctx, msg, fields...)
+}
+
+// WarnContext 全局警告级日志，并输出从ctx中提取的字段
+func WarnContext(ctx context.Context, msg string, fields ...Field) {
+	GetLogger().WarnContext(ctx, msg, fields...)
+}
+
+// ErrorContext 全局错误级日志，并输出从ctx中提取的字段
+func ErrorContext(ctx context.Context, msg string, fields ...Field) {
+	GetLogger().ErrorContext(ctx, msg, fields...)
+}
+
+// LogContext 全局按指定级别输出日志，并输出从ctx中提取的字段
+func LogContext(ctx context.Context, level LogLevel, msg string, fields ...Field) {
+	GetLogger().LogContext(ctx, level, msg, fields...)
+}
@@ -2,15 +2,22 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // LogLevel 定义日志级别
+// Debug到Panic依次为0到5，Trace为-1；自定义级别由RegisterLevel分配数值，级别之间的先后由Severity决定
 type LogLevel int
 
 const (
+	// TraceLevel 跟踪级别，比调试级别更详细
+	TraceLevel LogLevel = iota - 1
 	// DebugLevel 调试级别
-	DebugLevel LogLevel = iota
+	DebugLevel
 	// InfoLevel 信息级别
 	InfoLevel
 	// WarnLevel 警告级别
@@ -23,9 +30,11 @@ const (
 	PanicLevel
 )
 
-// String 返回日志级别的字符串表示
+// String 返回日志级别的字符串表示，自定义级别返回注册时的名称
 func (l LogLevel) String() string {
 	switch l {
+	case TraceLevel:
+		return "TRACE"
 	case DebugLevel:
 		return "DEBUG"
 	case InfoLevel:
@@ -38,15 +47,124 @@ func (l LogLevel) String() string {
 		return "FATAL"
 	case PanicLevel:
 		return "PANIC"
-	default:
-		return "UNKNOWN"
 	}
+	if name, ok := customLevelName(l); ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// builtin 判断是否为内置的日志级别
+func (l LogLevel) builtin() bool {
+	return l >= TraceLevel && l <= PanicLevel
+}
+
+// Severity 返回级别的严重程度，数值越大越严重，比较级别的先后时使用
+// 内置级别为数值的10倍，即Trace=-10、Debug=0、Info=10直到Panic=50，自定义级别为注册时指定的严重程度；
+// 其他低于Trace的数值低于所有级别，高于Panic的未注册数值高于所有级别
+func (l LogLevel) Severity() int {
+	switch {
+	case l.builtin():
+		return int(l) * 10
+	case l < TraceLevel:
+		return math.MinInt
+	}
+	if severity, ok := customLevelSeverity(l); ok {
+		return severity
+	}
+	return math.MaxInt
+}
+
+// valid 判断是否为内置或已注册的日志级别
+func (l LogLevel) valid() bool {
+	if l.builtin() {
+		return true
+	}
+	_, ok := customLevelName(l)
+	return ok
+}
+
+// ParseLevel 将字符串解析为日志级别，不区分大小写，也接受已注册的自定义级别名称和数字形式；
+// 数字必须是内置级别（-1到5）或已注册的自定义级别的数值
+func ParseLevel(text string) (LogLevel, error) {
+	name := strings.ToUpper(strings.TrimSpace(text))
+	switch name {
+	case "TRACE":
+		return TraceLevel, nil
+	case "DEBUG":
+		return DebugLevel, nil
+	case "INFO":
+		return InfoLevel, nil
+	case "WARN", "WARNING":
+		return WarnLevel, nil
+	case "ERROR":
+		return ErrorLevel, nil
+	case "FATAL":
+		return FatalLevel, nil
+	case "PANIC":
+		return PanicLevel, nil
+	}
+	if level, ok := customLevelByName(name); ok {
+		return level, nil
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(text)); err == nil {
+		return levelFromInt(n)
+	}
+	return InfoLevel, fmt.Errorf("unknown log level %q", text)
 }
 
-// Field 定义日志字段
-type Field struct {
-	Key   string
-	Value interface{}
+// levelFromInt 将数字转换为日志级别，数字必须是内置或已注册的自定义级别
+func levelFromInt(n int) (LogLevel, error) {
+	if level := LogLevel(n); level.valid() {
+		return level, nil
+	}
+	return InfoLevel, fmt.Errorf("unknown log level %d", n)
+}
+
+// MarshalText 实现encoding.TextMarshaler，输出小写的级别名称
+func (l LogLevel) MarshalText() ([]byte, error) {
+	if name := l.String(); name != "UNKNOWN" {
+		return []byte(strings.ToLower(name)), nil
+	}
+	return []byte(strconv.Itoa(int(l))), nil
+}
+
+// UnmarshalText 实现encoding.TextUnmarshaler
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = level
+	return nil
+}
+
+// UnmarshalJSON 同时接受字符串（"debug"）和数字（0）两种形式，数字按ParseLevel的规则解析
+func (l *LogLevel) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '"' {
+		n, err := strconv.Atoi(string(data))
+		if err != nil {
+			return fmt.Errorf("invalid log level %s", data)
+		}
+		level, err := levelFromInt(n)
+		if err != nil {
+			return err
+		}
+		*l = level
+		return nil
+	}
+	text, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(text))
+}
+
+// AppendFields 合并两组字段，总是返回新的切片，避免派生实例之间共享底层数组
+func AppendFields(base []Field, fields []Field) []Field {
+	merged := make([]Field, 0, len(base)+len(fields))
+	merged = append(merged, base...)
+	return append(merged, fields...)
 }
 
 // Logger 日志门面接口
@@ -56,47 +174,93 @@ type Logger interface {
 	// GetLevel 获取当前日志级别
 	GetLevel() LogLevel
 
+	// Trace 输出跟踪级日志
+	Trace(msg string, fields ...Field)
+	// Tracef 输出格式化的跟踪级日志
+	Tracef(format string, args ...interface{})
+	// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+	Tracew(msg string, keysAndValues ...interface{})
+
 	// Debug 输出调试级日志
 	Debug(msg string, fields ...Field)
 	// Debugf 输出格式化的调试级日志
 	Debugf(format string, args ...interface{})
+	// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+	Debugw(msg string, keysAndValues ...interface{})
 
 	// Info 输出信息级日志
 	Info(msg string, fields ...Field)
 	// Infof 输出格式化的信息级日志
 	Infof(format string, args ...interface{})
+	// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+	Infow(msg string, keysAndValues ...interface{})
 
 	// Warn 输出警告级日志
 	Warn(msg string, fields ...Field)
 	// Warnf 输出格式化的警告级日志
 	Warnf(format string, args ...interface{})
+	// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+	Warnw(msg string, keysAndValues ...interface{})
 
 	// Error 输出错误级日志
 	Error(msg string, fields ...Field)
 	// Errorf 输出格式化的错误级日志
 	Errorf(format string, args ...interface{})
+	// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+	Errorw(msg string, keysAndValues ...interface{})
 
 	// Fatal 输出致命级日志并退出程序
 	Fatal(msg string, fields ...Field)
 	// Fatalf 输出格式化的致命级日志并退出程序
 	Fatalf(format string, args ...interface{})
+	// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+	Fatalw(msg string, keysAndValues ...interface{})
 
 	// Panic 输出恐慌级日志并触发panic
 	Panic(msg string, fields ...Field)
 	// Panicf 输出格式化的恐慌级日志并触发panic
 	Panicf(format string, args ...interface{})
+	// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+	Panicw(msg string, keysAndValues ...interface{})
+
+	// Log 按指定级别输出日志，可用于自定义级别；FatalLevel和PanicLevel与Fatal、Panic的行为相同
+	Log(level LogLevel, msg string, fields ...Field)
+	// Logf 按指定级别输出格式化的日志
+	Logf(level LogLevel, format string, args ...interface{})
+	// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+	Logw(level LogLevel, msg string, keysAndValues ...interface{})
+
+	// TraceContext 输出跟踪级日志，并输出通过注册的提取器从ctx中提取的字段
+	TraceContext(ctx context.Context, msg string, fields ...Field)
+	// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+	DebugContext(ctx context.Context, msg string, fields ...Field)
+	// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+	InfoContext(ctx context.Context, msg string, fields ...Field)
+	// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+	WarnContext(ctx context.Context, msg string, fields ...Field)
+	// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+	ErrorContext(ctx context.Context, msg string, fields ...Field)
+	// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+	LogContext(ctx context.Context, level LogLevel, msg string, fields ...Field)
+
+	// Named 创建名称为 "当前名称.name" 的子日志实例，子实例有自己的级别
+	Named(name string) Logger
 
 	// WithFields 添加字段到日志
 	WithFields(fields ...Field) Logger
 	// WithField 添加单个字段到日志
 	WithField(key string, value interface{}) Logger
-	// WithContext 添加上下文到日志
+	// WithContext 添加上下文到日志，之后的每条日志都会输出从ctx中提取的字段
 	WithContext(ctx context.Context) Logger
 	// WithError 添加错误信息到日志
 	WithError(err error) Logger
 	// WithTime 添加时间到日志
 	WithTime(t time.Time) Logger
+	// WithStack 为派生实例输出的每条日志附加调用堆栈，不受WithStackTrace设置的级别限制
+	WithStack() Logger
 
+	// IsTraceEnabled 检查跟踪级别是否启用
+	IsTraceEnabled() bool
 	// IsDebugEnabled 检查调试级别是否启用
 	IsDebugEnabled() bool
 	// IsInfoEnabled 检查信息级别是否启用
@@ -109,9 +273,14 @@ type Logger interface {
 	IsFatalEnabled() bool
 	// IsPanicEnabled 检查恐慌级别是否启用
 	IsPanicEnabled() bool
+	// IsLevelEnabled 检查指定级别是否启用，可用于自定义级别
+	IsLevelEnabled(level LogLevel) bool
 
 	// Sync 刷新日志缓冲区
 	Sync() error
+
+	// Close 刷新并关闭日志输出，对所有派生实例生效，关闭后写入文件的日志会被丢弃
+	Close() error
 }
 
 // LoggerProvider 日志提供者接口
@@ -122,6 +291,13 @@ type LoggerProvider interface {
 	CreateWithConfig(name string, config map[string]interface{}) Logger
 }
 
+// Reconfigurable 支持在运行时重新配置的日志实例
+// 重新配置会作用于该实例以及通过WithFields、WithContext等派生出的所有实例
+type Reconfigurable interface {
+	// Reconfigure 使用新的配置选项重新配置级别、格式和输出，未指定的选项恢复默认值
+	Reconfigure(opts ...Option) error
+}
+
 // Option 日志配置选项
 type Option func(*LoggerOptions)
 
@@ -130,14 +306,47 @@ type LoggerOptions struct {
 	Level          LogLevel
 	Format         string
 	OutputPath     string
-	MaxLogSize     int64         // 单个日志文件最大大小（MB）
-	MaxLogAge      time.Duration // 日志文件最大保留时间
-	MaxLogFiles    int           // 最大保留日志文件数量
-	CompressLogs   bool          // 是否压缩旧日志
-	MaxMessageSize int           // 单条日志最大大小（KB）
+	Outputs        []OutputConfig // 多个输出，设置后OutputPath不再生效
+	MaxLogSize     int64          // 单个日志文件最大大小（MB）
+	MaxLogAge      time.Duration  // 日志文件最大保留时间
+	MaxLogFiles    int            // 最大保留日志文件数量
+	CompressLogs   bool           // 是否压缩旧日志
+	RotateInterval time.Duration  // 按时间轮转的间隔，0表示只按大小轮转
+	CurrentLink    string         // 指向当前日志文件的符号链接路径，为空时不创建
+	MaxMessageSize int            // 单条日志最大大小（KB）
+	AsyncQueueSize int            // 异步写入队列的容量（条），0表示同步写入
+	OverflowPolicy OverflowPolicy // 异步写入队列已满时的处理方式
+	OverflowLevel  LogLevel       // OverflowDropBelowLevel时不会被丢弃的最低级别
+	Caller         bool           // 是否输出调用位置
+	CallerSkip     int            // 输出调用位置时额外跳过的调用层数
+	StackTrace     bool           // 是否为级别不低于StackLevel的日志附加堆栈
+	StackLevel     LogLevel       // 附加堆栈的最低级别
+	AtomicLevel    *AtomicLevel   // 共享的日志级别，为nil时按Level创建
 	Config         map[string]interface{}
 }
 
+// NewLoggerOptions 创建带有默认值的配置选项并应用opts
+func NewLoggerOptions(opts ...Option) *LoggerOptions {
+	options := &LoggerOptions{
+		Level:          InfoLevel,
+		Format:         "text",
+		OutputPath:     "stdout",
+		MaxLogSize:     100,                // 默认100MB
+		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
+		MaxLogFiles:    10,                 // 默认10个文件
+		CompressLogs:   false,              // 默认不压缩
+		MaxMessageSize: 0,                  // 默认不限制
+		OverflowPolicy: OverflowBlock,      // 默认队列已满时等待
+		OverflowLevel:  WarnLevel,          // 默认只丢弃警告以下的日志
+		Config:         make(map[string]interface{}),
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
 // WithLevel 设置日志级别
 func WithLevel(level LogLevel) Option {
 	return func(opt *LoggerOptions) {
@@ -194,9 +403,46 @@ func WithCompressLogs(compress bool) Option {
 	}
 }
 
+// WithRotateInterval 设置按时间轮转的间隔，如time.Hour每小时轮转、24*time.Hour每天轮转
+// 输出路径可以包含时间模式，如"logs/app-%Y%m%d-%H.log"，此时每个周期写入一个新文件
+func WithRotateInterval(interval time.Duration) Option {
+	return func(opt *LoggerOptions) {
+		opt.RotateInterval = interval
+	}
+}
+
+// WithCurrentLink 设置指向当前日志文件的符号链接，轮转后链接指向新文件
+func WithCurrentLink(link string) Option {
+	return func(opt *LoggerOptions) {
+		opt.CurrentLink = link
+	}
+}
+
 // WithMaxMessageSize 设置单条日志最大大小（KB）
 func WithMaxMessageSize(size int) Option {
 	return func(opt *LoggerOptions) {
 		opt.MaxMessageSize = size
 	}
 }
+
+// WithAsync 开启异步写入，日志先放入容量为queueSize条的队列，由后台协程批量写入，0表示同步写入
+// 调用Sync会等待队列中的日志全部写入
+func WithAsync(queueSize int) Option {
+	return func(opt *LoggerOptions) {
+		opt.AsyncQueueSize = queueSize
+	}
+}
+
+// WithOverflowPolicy 设置异步写入队列已满时的处理方式
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(opt *LoggerOptions) {
+		opt.OverflowPolicy = policy
+	}
+}
+
+// WithOverflowLevel 设置OverflowDropBelowLevel时不会被丢弃的最低级别
+func WithOverflowLevel(level LogLevel) Option {
+	return func(opt *LoggerOptions) {
+		opt.OverflowLevel = level
+	}
+}
@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// LoggingConfig 应用级的日志配置文档
+// Default 为默认配置，Loggers 按名称定义各个日志实例，未设置的项继承自 Default
+type LoggingConfig struct {
+	Default *LogConfig   `json:"default" yaml:"default"` // 默认配置
+	Loggers []*LogConfig `json:"loggers" yaml:"loggers"` // 按名称定义的日志实例
+}
+
+// NewLoggingConfig 创建只包含默认配置的配置文档
+func NewLoggingConfig() *LoggingConfig {
+	return &LoggingConfig{
+		Default: newDefaultDefinition(),
+		Loggers: make([]*LogConfig, 0),
+	}
+}
+
+// newDefaultDefinition 创建文档中的默认配置
+// Provider 留空，表示使用日志工厂的默认提供者
+func newDefaultDefinition() *LogConfig {
+	config := NewLogConfig()
+	config.Provider = ""
+	return config
+}
+
+// WithDefault 设置默认配置
+func (c *LoggingConfig) WithDefault(config *LogConfig) *LoggingConfig {
+	c.Default = config
+	return c
+}
+
+// WithLogger 添加一个命名日志实例的配置
+func (c *LoggingConfig) WithLogger(config *LogConfig) *LoggingConfig {
+	c.Loggers = append(c.Loggers, config)
+	return c
+}
+
+// Find 查找指定名称的定义，没有同名定义时返回nil
+func (c *LoggingConfig) Find(name string) *LogConfig {
+	for _, def := range c.Loggers {
+		if def != nil && def.Name == name {
+			return def.Clone()
+		}
+	}
+	return nil
+}
+
+// Lookup 查找指定名称的日志配置
+// 存在同名定义时返回该定义的副本，否则返回默认配置的副本并将名称设置为name
+func (c *LoggingConfig) Lookup(name string) *LogConfig {
+	if def := c.Find(name); def != nil {
+		return def
+	}
+
+	var config *LogConfig
+	if c.Default != nil {
+		config = c.Default.Clone()
+	} else {
+		config = newDefaultDefinition()
+	}
+	config.Name = name
+	return config
+}
+
+// withDefinition 返回替换或追加了同名定义的配置文档副本
+func (c *LoggingConfig) withDefinition(config *LogConfig) *LoggingConfig {
+	doc := &LoggingConfig{Default: c.Default, Loggers: make([]*LogConfig, 0, len(c.Loggers)+1)}
+	replaced := false
+	for _, def := range c.Loggers {
+		if def != nil && def.Name == config.Name {
+			def = config.Clone()
+			replaced = true
+		}
+		doc.Loggers = append(doc.Loggers, def)
+	}
+	if !replaced {
+		doc.Loggers = append(doc.Loggers, config.Clone())
+	}
+	return doc
+}
+
+// ValidateStrict 严格验证默认配置和所有命名定义，返回所有非法的配置项
+func (c *LoggingConfig) ValidateStrict() error {
+	var errs []error
+	if c.Default != nil {
+		if err := c.Default.ValidateStrict(); err != nil {
+			errs = append(errs, fmt.Errorf("default: %w", err))
+		}
+	}
+	for _, config := range c.Loggers {
+		if err := config.ValidateStrict(); err != nil {
+			errs = append(errs, fmt.Errorf("logger %q: %w", config.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UnmarshalJSON 实现json.Unmarshaler
+// 文档中既没有 default 也没有 loggers 时，整个文档被视为默认配置
+func (c *LoggingConfig) UnmarshalJSON(data []byte) error {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+
+	rawDefault, hasDefault := keys["default"]
+	rawLoggers, hasLoggers := keys["loggers"]
+	if !hasDefault && !hasLoggers {
+		rawDefault = data
+	}
+
+	def := newDefaultDefinition()
+	if hasValue(rawDefault) {
+		if err := json.Unmarshal(rawDefault, def); err != nil {
+			return fmt.Errorf("default: %w", err)
+		}
+	}
+
+	var entries []json.RawMessage
+	if hasValue(rawLoggers) {
+		if err := json.Unmarshal(rawLoggers, &entries); err != nil {
+			return fmt.Errorf("loggers: %w", err)
+		}
+	}
+
+	loggers := make([]*LogConfig, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for i, entry := range entries {
+		// 每个命名实例从默认配置开始，只覆盖自己声明的项
+		config := def.Clone()
+		config.Name = ""
+		if err := json.Unmarshal(entry, config); err != nil {
+			return fmt.Errorf("loggers[%d]: %w", i, err)
+		}
+		if config.Name == "" {
+			return fmt.Errorf("loggers[%d]: name is required", i)
+		}
+		if seen[config.Name] {
+			return fmt.Errorf("loggers[%d]: duplicate logger name %q", i, config.Name)
+		}
+		seen[config.Name] = true
+		loggers = append(loggers, config)
+	}
+
+	c.Default = def
+	c.Loggers = loggers
+	return nil
+}
@@ -0,0 +1,151 @@
+package logger
+
+import (
+	"errors"
+	"io"
+	"math"
+	"os"
+)
+
+// NewOutputWriter 根据配置创建日志输出
+// "stdout" 或空路径输出到标准输出，"stderr" 输出到标准错误，其他路径写入文件并使用lumberjack按大小轮转；
+// 进程内相同路径的文件输出共享同一个lumberjack，由所有日志实例一起写入和轮转
+func NewOutputWriter(options *LoggerOptions) io.Writer {
+	return newOutputWriter(options, nil)
+}
+
+// newOutputWriter 根据配置创建日志输出，replaced中的共享文件写入器的轮转配置会被options替换
+func newOutputWriter(options *LoggerOptions, replaced map[*sharedFileWriter]bool) io.Writer {
+	switch options.OutputPath {
+	case "", "stdout":
+		return os.Stdout
+	case "stderr":
+		return os.Stderr
+	default:
+		return openSharedFile(options, replaced)
+	}
+}
+
+// CloseOutputWriter 关闭由NewOutputWriter创建的输出，标准输出和标准错误不会被关闭，
+// 共享的日志文件在最后一个使用它的输出关闭后才会关闭
+func CloseOutputWriter(w io.Writer) error {
+	if w == os.Stdout || w == os.Stderr {
+		return nil
+	}
+	if closer, ok := w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// minOutputLevel 没有设置最低级别的输出使用的级别，低于所有内置和自定义级别
+const minOutputLevel = LogLevel(math.MinInt)
+
+// Output 根据配置创建的一个日志输出
+type Output struct {
+	Writer io.Writer
+	Format string   // 输出格式（text/json）
+	Level  LogLevel // 最低级别，只有同时满足日志实例级别和该级别的日志才会写入
+}
+
+// Enabled 判断级别为level的日志是否写入该输出
+func (o Output) Enabled(level LogLevel) bool {
+	return level.Severity() >= o.Level.Severity()
+}
+
+// BelowLevelWriter 返回OverflowDropBelowLevel的异步输出写入低于OverflowLevel的日志时使用的输出，
+// 队列已满时直接丢弃日志并计数，不会等待；其他输出返回nil
+// 提供者需要为它单独创建写入对象，并按BelowLevel选择写入哪一个
+func (o Output) BelowLevelWriter() io.Writer {
+	if async, ok := o.Writer.(*asyncWriter); ok && async.policy == OverflowDropBelowLevel {
+		return belowLevelWriter{async}
+	}
+	return nil
+}
+
+// BelowLevel 判断级别为level的日志是否应写入BelowLevelWriter
+func (o Output) BelowLevel(level LogLevel) bool {
+	async, ok := o.Writer.(*asyncWriter)
+	return ok && async.policy == OverflowDropBelowLevel && level.Severity() < async.level.Severity()
+}
+
+// Dropped 返回该输出因异步写入队列已满丢弃的日志条数，同步输出总是返回0
+func (o Output) Dropped() uint64 {
+	if async, ok := o.Writer.(*asyncWriter); ok {
+		return async.Dropped()
+	}
+	return 0
+}
+
+// NewOutputs 根据配置创建日志实例的所有输出
+// 配置了Outputs时按它们创建，其中为空或为0的项使用options中的格式和轮转配置；
+// 否则只有OutputPath一个输出，格式为options.Format。AsyncQueueSize大于0时每个输出都异步写入
+func NewOutputs(options *LoggerOptions) []Output {
+	return newOutputs(options, nil)
+}
+
+// ReplaceOutputs 重新配置日志实例时创建新的输出，用于替换old
+// 与NewOutputs不同，old中的文件输出不会与新的输出产生轮转配置冲突，同一路径的文件改用新的轮转配置；
+// old仍需由调用方在替换后关闭
+func ReplaceOutputs(old []Output, options *LoggerOptions) []Output {
+	replaced := make(map[*sharedFileWriter]bool)
+	for _, output := range old {
+		writer := output.Writer
+		if async, ok := writer.(*asyncWriter); ok {
+			writer = async.writer
+		}
+		if file, ok := writer.(*sharedFileWriter); ok {
+			replaced[file] = true
+		}
+	}
+	return newOutputs(options, replaced)
+}
+
+// newOutputs 根据配置创建日志实例的所有输出，replaced含义同newOutputWriter
+func newOutputs(options *LoggerOptions, replaced map[*sharedFileWriter]bool) []Output {
+	if len(options.Outputs) == 0 {
+		return []Output{{Writer: newWriter(options, replaced), Format: options.Format, Level: minOutputLevel}}
+	}
+
+	outputs := make([]Output, len(options.Outputs))
+	for i, config := range options.Outputs {
+		outputOptions := config.apply(options)
+		level := minOutputLevel
+		if config.Level != nil {
+			level = *config.Level
+		}
+		outputs[i] = Output{Writer: newWriter(outputOptions, replaced), Format: outputOptions.Format, Level: level}
+	}
+	return outputs
+}
+
+// newWriter 创建输出，按配置包装为异步输出
+func newWriter(options *LoggerOptions, replaced map[*sharedFileWriter]bool) io.Writer {
+	writer := newOutputWriter(options, replaced)
+	if options.AsyncQueueSize > 0 {
+		return newAsyncWriter(writer, options)
+	}
+	return writer
+}
+
+// SyncOutputs 刷新由NewOutputs创建的输出，异步输出会等待队列中的日志全部写入，返回所有刷新失败的错误
+func SyncOutputs(outputs []Output) error {
+	var errs []error
+	for _, output := range outputs {
+		if err := syncWriter(output.Writer); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CloseOutputs 关闭由NewOutputs创建的输出，返回所有关闭失败的错误
+func CloseOutputs(outputs []Output) error {
+	var errs []error
+	for _, output := range outputs {
+		if err := CloseOutputWriter(output.Writer); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,220 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OutputConfig 日志实例的一个输出，通过WithOutputs或LogConfig.Outputs同时写入多个输出
+// 除Path外为空或为0的项使用日志实例的配置，例如：
+//
+//	WithOutputs(
+//		NewOutputConfig("stdout"),
+//		NewOutputConfig("app.log").WithFormat("json"),
+//		NewOutputConfig("error.log").WithLevel(ErrorLevel),
+//	)
+type OutputConfig struct {
+	Path           string        `json:"path" yaml:"path"`                                         // "stdout"、"stderr"或文件路径
+	Level          *LogLevel     `json:"level,omitempty" yaml:"level,omitempty"`                   // 最低级别，为nil时写入日志实例输出的所有日志
+	Format         string        `json:"format,omitempty" yaml:"format,omitempty"`                 // 日志格式（text/json）
+	MaxLogSize     int64         `json:"maxLogSize,omitempty" yaml:"maxLogSize,omitempty"`         // 单个日志文件最大大小（MB）
+	MaxLogAge      time.Duration `json:"maxLogAge,omitempty" yaml:"maxLogAge,omitempty"`           // 日志文件最大保留时间
+	MaxLogFiles    int           `json:"maxLogFiles,omitempty" yaml:"maxLogFiles,omitempty"`       // 最大保留日志文件数量
+	CompressLogs   *bool         `json:"compressLogs,omitempty" yaml:"compressLogs,omitempty"`     // 是否压缩旧日志
+	RotateInterval time.Duration `json:"rotateInterval,omitempty" yaml:"rotateInterval,omitempty"` // 按时间轮转的间隔
+	CurrentLink    string        `json:"currentLink,omitempty" yaml:"currentLink,omitempty"`       // 指向当前日志文件的符号链接路径，不使用日志实例的配置
+}
+
+// NewOutputConfig 创建写入path的输出配置
+func NewOutputConfig(path string) OutputConfig {
+	return OutputConfig{Path: path}
+}
+
+// WithLevel 设置输出的最低级别
+func (c OutputConfig) WithLevel(level LogLevel) OutputConfig {
+	c.Level = &level
+	return c
+}
+
+// WithFormat 设置输出的日志格式
+func (c OutputConfig) WithFormat(format string) OutputConfig {
+	c.Format = format
+	return c
+}
+
+// WithMaxLogSize 设置单个日志文件最大大小（MB）
+func (c OutputConfig) WithMaxLogSize(size int64) OutputConfig {
+	c.MaxLogSize = size
+	return c
+}
+
+// WithMaxLogAge 设置日志文件最大保留时间
+func (c OutputConfig) WithMaxLogAge(age time.Duration) OutputConfig {
+	c.MaxLogAge = age
+	return c
+}
+
+// WithMaxLogFiles 设置最大保留日志文件数量
+func (c OutputConfig) WithMaxLogFiles(files int) OutputConfig {
+	c.MaxLogFiles = files
+	return c
+}
+
+// WithCompressLogs 设置是否压缩旧日志
+func (c OutputConfig) WithCompressLogs(compress bool) OutputConfig {
+	c.CompressLogs = &compress
+	return c
+}
+
+// WithRotateInterval 设置按时间轮转的间隔
+func (c OutputConfig) WithRotateInterval(interval time.Duration) OutputConfig {
+	c.RotateInterval = interval
+	return c
+}
+
+// WithCurrentLink 设置指向当前日志文件的符号链接路径
+func (c OutputConfig) WithCurrentLink(link string) OutputConfig {
+	c.CurrentLink = link
+	return c
+}
+
+// WithOutputs 设置多个日志输出，设置后OutputPath不再生效
+func WithOutputs(outputs ...OutputConfig) Option {
+	return func(opt *LoggerOptions) {
+		opt.Outputs = cloneOutputs(outputs)
+	}
+}
+
+// apply 返回以options为基础、应用了该输出配置的选项
+func (c OutputConfig) apply(options *LoggerOptions) *LoggerOptions {
+	applied := *options
+	applied.OutputPath = c.Path
+	applied.CurrentLink = c.CurrentLink // 每个文件的符号链接不同，不使用日志实例的配置
+	if c.Format != "" {
+		applied.Format = c.Format
+	}
+	if c.MaxLogSize > 0 {
+		applied.MaxLogSize = c.MaxLogSize
+	}
+	if c.MaxLogAge > 0 {
+		applied.MaxLogAge = c.MaxLogAge
+	}
+	if c.MaxLogFiles > 0 {
+		applied.MaxLogFiles = c.MaxLogFiles
+	}
+	if c.CompressLogs != nil {
+		applied.CompressLogs = *c.CompressLogs
+	}
+	if c.RotateInterval > 0 {
+		applied.RotateInterval = c.RotateInterval
+	}
+	return &applied
+}
+
+// validate 返回输出配置中非法的项，prefix为错误信息中的配置项前缀
+func (c OutputConfig) validate(prefix string) []string {
+	var problems []string
+	if c.Path == "" {
+		problems = append(problems, prefix+".path must not be empty")
+	}
+	if err := validateFilePattern(c.Path); err != nil {
+		problems = append(problems, fmt.Sprintf("%s.path: %v", prefix, err))
+	}
+	if c.Level != nil && !c.Level.valid() {
+		problems = append(problems, fmt.Sprintf("%s.level: unknown level %d", prefix, int(*c.Level)))
+	}
+	if c.Format != "" && c.Format != "text" && c.Format != "json" {
+		problems = append(problems, fmt.Sprintf("%s.format %q must be text or json", prefix, c.Format))
+	}
+	if c.MaxLogSize < 0 {
+		problems = append(problems, fmt.Sprintf("%s.maxLogSize must not be negative, got %d", prefix, c.MaxLogSize))
+	}
+	if c.MaxLogAge < 0 {
+		problems = append(problems, fmt.Sprintf("%s.maxLogAge must not be negative, got %v", prefix, c.MaxLogAge))
+	}
+	if c.MaxLogFiles < 0 {
+		problems = append(problems, fmt.Sprintf("%s.maxLogFiles must not be negative, got %d", prefix, c.MaxLogFiles))
+	}
+	if c.RotateInterval < 0 {
+		problems = append(problems, fmt.Sprintf("%s.rotateInterval must not be negative, got %v", prefix, c.RotateInterval))
+	}
+	return problems
+}
+
+// cloneOutputs 复制输出配置，包括级别和压缩配置指向的值
+func cloneOutputs(outputs []OutputConfig) []OutputConfig {
+	if outputs == nil {
+		return nil
+	}
+	clone := make([]OutputConfig, len(outputs))
+	for i, output := range outputs {
+		if output.Level != nil {
+			level := *output.Level
+			output.Level = &level
+		}
+		if output.CompressLogs != nil {
+			compress := *output.CompressLogs
+			output.CompressLogs = &compress
+		}
+		clone[i] = output
+	}
+	return clone
+}
+
+// outputConfigJSON 用于OutputConfig的JSON编解码，时间长度和大小使用可读文本
+type outputConfigJSON struct {
+	*outputConfigAlias
+	MaxLogSize     json.RawMessage `json:"maxLogSize,omitempty"`
+	MaxLogAge      json.RawMessage `json:"maxLogAge,omitempty"`
+	RotateInterval json.RawMessage `json:"rotateInterval,omitempty"`
+}
+
+type outputConfigAlias OutputConfig
+
+// MarshalJSON 实现json.Marshaler，与LogConfig相同，时间长度输出为 "7d"，大小输出为 "100MB"
+func (c OutputConfig) MarshalJSON() ([]byte, error) {
+	alias := outputConfigAlias(c)
+	aux := outputConfigJSON{outputConfigAlias: &alias}
+	if c.MaxLogSize != 0 {
+		aux.MaxLogSize, _ = json.Marshal(FormatSize(c.MaxLogSize * MB))
+	}
+	if c.MaxLogAge != 0 {
+		aux.MaxLogAge, _ = json.Marshal(FormatDuration(c.MaxLogAge))
+	}
+	if c.RotateInterval != 0 {
+		aux.RotateInterval, _ = json.Marshal(FormatDuration(c.RotateInterval))
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON 实现json.Unmarshaler，maxLogAge、rotateInterval和maxLogSize接受与LogConfig相同的写法
+func (c *OutputConfig) UnmarshalJSON(data []byte) error {
+	aux := outputConfigJSON{outputConfigAlias: (*outputConfigAlias)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if hasValue(aux.MaxLogSize) {
+		size, err := decodeSize(aux.MaxLogSize, MB)
+		if err != nil {
+			return fmt.Errorf("maxLogSize: %w", err)
+		}
+		c.MaxLogSize = sizeInUnits(size, MB)
+	}
+	if hasValue(aux.MaxLogAge) {
+		age, err := decodeDuration(aux.MaxLogAge)
+		if err != nil {
+			return fmt.Errorf("maxLogAge: %w", err)
+		}
+		c.MaxLogAge = age
+	}
+	if hasValue(aux.RotateInterval) {
+		interval, err := decodeDuration(aux.RotateInterval)
+		if err != nil {
+			return fmt.Errorf("rotateInterval: %w", err)
+		}
+		c.RotateInterval = interval
+	}
+	return nil
+}
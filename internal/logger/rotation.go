@@ -0,0 +1,142 @@
+package logger
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 日志文件路径中支持的时间模式：
+//
+//	%Y 四位年份  %y 两位年份  %m 月  %d 日  %j 一年中的第几天
+//	%H 小时      %M 分钟      %S 秒  %% 百分号
+//
+// 例如 "logs/app-%Y%m%d-%H.log" 在2024年5月1日9点写入 "logs/app-20240501-09.log"
+
+// hasFilePattern 判断路径中是否包含时间模式
+func hasFilePattern(path string) bool {
+	return strings.ContainsRune(expandFilePattern(path, func(byte) string { return "\x00" }), 0)
+}
+
+// validateFilePattern 检查路径中的时间模式，返回第一个不支持的模式
+func validateFilePattern(path string) error {
+	for i := 0; i < len(path); i++ {
+		if path[i] != '%' {
+			continue
+		}
+		if i+1 == len(path) {
+			return fmt.Errorf("pattern %q ends with %%", path)
+		}
+		i++
+		switch path[i] {
+		case 'Y', 'y', 'm', 'd', 'j', 'H', 'M', 'S', '%':
+		default:
+			return fmt.Errorf("pattern %q: unsupported directive %%%c", path, path[i])
+		}
+	}
+	return nil
+}
+
+// formatFilePattern 使用t替换路径中的时间模式，不支持的模式原样保留
+func formatFilePattern(path string, t time.Time) string {
+	return expandFilePattern(path, func(directive byte) string {
+		switch directive {
+		case 'Y':
+			return fmt.Sprintf("%04d", t.Year())
+		case 'y':
+			return fmt.Sprintf("%02d", t.Year()%100)
+		case 'm':
+			return fmt.Sprintf("%02d", int(t.Month()))
+		case 'd':
+			return fmt.Sprintf("%02d", t.Day())
+		case 'j':
+			return fmt.Sprintf("%03d", t.YearDay())
+		case 'H':
+			return fmt.Sprintf("%02d", t.Hour())
+		case 'M':
+			return fmt.Sprintf("%02d", t.Minute())
+		case 'S':
+			return fmt.Sprintf("%02d", t.Second())
+		}
+		return ""
+	})
+}
+
+// filePatternGlob 将路径中的时间模式替换为通配符，用于查找该模式写入过的所有文件
+func filePatternGlob(path string) string {
+	return expandFilePattern(path, func(byte) string {
+		return "*"
+	})
+}
+
+// expandFilePattern 使用replace替换路径中支持的时间模式，%%替换为%
+func expandFilePattern(path string, replace func(directive byte) string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] != '%' || i+1 == len(path) {
+			b.WriteByte(path[i])
+			continue
+		}
+		directive := path[i+1]
+		switch directive {
+		case '%':
+			b.WriteByte('%')
+		case 'Y', 'y', 'm', 'd', 'j', 'H', 'M', 'S':
+			b.WriteString(replace(directive))
+		default:
+			b.WriteByte('%')
+			b.WriteByte(directive)
+		}
+		i++
+	}
+	return b.String()
+}
+
+// patternInterval 返回时间模式中最小的时间单位，未设置轮转间隔时按它轮转
+func patternInterval(path string) time.Duration {
+	switch {
+	case strings.Contains(path, "%S"):
+		return time.Second
+	case strings.Contains(path, "%M"):
+		return time.Minute
+	case strings.Contains(path, "%H"):
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// backupGlob 返回lumberjack为filename按大小轮转时生成的备份文件的通配符
+func backupGlob(filename string) string {
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + "-*" + ext
+}
+
+// rotationPeriod 返回t所在轮转周期的开始时间和下一个周期的开始时间
+// 小于一天的间隔从本地时间零点开始对齐，如每小时在整点、每15分钟在00/15/30/45分轮转；
+// 整天数的间隔从本地时间的周一零点开始对齐，因此7天的间隔在每周一轮转；其他间隔按绝对时间对齐
+func rotationPeriod(t time.Time, interval time.Duration) (start time.Time, next time.Time) {
+	const day = 24 * time.Hour
+	switch {
+	case interval < day:
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		nextMidnight := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		start = midnight.Add(t.Sub(midnight).Truncate(interval))
+		next = start.Add(interval)
+		if next.After(nextMidnight) {
+			next = nextMidnight
+		}
+		return start, next
+	case interval%day == 0:
+		days := int(interval / day)
+		date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		elapsed := int(date.Sub(time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC)) / day) // 1970-01-05是周一
+		offset := elapsed - ((elapsed%days)+days)%days
+		start = time.Date(1970, 1, 5+offset, 0, 0, 0, 0, t.Location())
+		return start, start.AddDate(0, 0, days)
+	default:
+		start = t.Truncate(interval)
+		return start, start.Add(interval)
+	}
+}
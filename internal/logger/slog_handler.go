@@ -0,0 +1,185 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// slogHandler 将slog的日志记录输出到日志门面的slog.Handler
+type slogHandler struct {
+	logger Logger      // 为nil时每次输出都使用当前的全局日志实例
+	attrs  []Field     // WithAttrs添加的、不在任何分组中的字段
+	groups []slogGroup // WithGroup打开的分组，由外到内
+}
+
+// slogGroup 一个打开的分组及WithAttrs添加到其中的字段
+type slogGroup struct {
+	name   string
+	fields []Field
+}
+
+// NewSlogHandler 创建输出到指定日志实例的slog.Handler，l为nil时输出到全局日志实例
+// slog的级别映射为不高于它的最近的门面级别，低于Debug的映射为Trace，不会映射为Fatal和Panic；
+// 分组输出为嵌套的对象字段
+func NewSlogHandler(l Logger) slog.Handler {
+	return &slogHandler{logger: l}
+}
+
+// target 返回输出的日志实例
+func (h *slogHandler) target() Logger {
+	if h.logger != nil {
+		return h.logger
+	}
+	return GetLogger()
+}
+
+// Enabled 实现slog.Handler
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.target().IsLevelEnabled(fromSlogLevel(level))
+}
+
+// Handle 实现slog.Handler，记录的时间由日志提供者生成，调用位置按门面的规则查找
+func (h *slogHandler) Handle(ctx context.Context, record slog.Record) error {
+	fields := make([]Field, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		fields = appendSlogAttr(fields, attr)
+		return true
+	})
+
+	// 从最内层的分组开始，把字段依次收进外层分组
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		group := h.groups[i]
+		groupFields := AppendFields(group.fields, fields)
+		if len(groupFields) == 0 {
+			fields = nil
+			continue
+		}
+		fields = []Field{Object(group.name, fieldObject(groupFields))}
+	}
+
+	h.target().LogContext(ctx, fromSlogLevel(record.Level), record.Message, AppendFields(h.attrs, fields)...)
+	return nil
+}
+
+// WithAttrs 实现slog.Handler
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var fields []Field
+	for _, attr := range attrs {
+		fields = appendSlogAttr(fields, attr)
+	}
+	if len(fields) == 0 {
+		return h
+	}
+
+	handler := *h
+	if len(h.groups) == 0 {
+		handler.attrs = AppendFields(h.attrs, fields)
+		return &handler
+	}
+	handler.groups = append([]slogGroup(nil), h.groups...)
+	last := &handler.groups[len(handler.groups)-1]
+	last.fields = AppendFields(last.fields, fields)
+	return &handler
+}
+
+// WithGroup 实现slog.Handler
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	handler := *h
+	handler.groups = append(append([]slogGroup(nil), h.groups...), slogGroup{name: name})
+	return &handler
+}
+
+// fromSlogLevel 将slog的级别映射为不高于它的最近的门面级别
+func fromSlogLevel(level slog.Level) LogLevel {
+	switch {
+	case level >= slog.LevelError:
+		return ErrorLevel
+	case level >= slog.LevelWarn:
+		return WarnLevel
+	case level >= slog.LevelInfo:
+		return InfoLevel
+	case level >= slog.LevelDebug:
+		return DebugLevel
+	default:
+		return TraceLevel
+	}
+}
+
+// appendSlogAttr 将slog的属性转换为字段追加到fields
+// 按slog的约定，空属性被忽略，键为空的分组展开到当前层级，没有属性的分组被忽略
+func appendSlogAttr(fields []Field, attr slog.Attr) []Field {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return fields
+	}
+
+	value := attr.Value
+	switch value.Kind() {
+	case slog.KindString:
+		return append(fields, String(attr.Key, value.String()))
+	case slog.KindInt64:
+		return append(fields, Int64(attr.Key, value.Int64()))
+	case slog.KindUint64:
+		return append(fields, Any(attr.Key, value.Uint64()))
+	case slog.KindFloat64:
+		return append(fields, Float64(attr.Key, value.Float64()))
+	case slog.KindBool:
+		return append(fields, Bool(attr.Key, value.Bool()))
+	case slog.KindDuration:
+		return append(fields, Duration(attr.Key, value.Duration()))
+	case slog.KindTime:
+		return append(fields, Time(attr.Key, value.Time()))
+	case slog.KindGroup:
+		var groupFields []Field
+		for _, groupAttr := range value.Group() {
+			groupFields = appendSlogAttr(groupFields, groupAttr)
+		}
+		if len(groupFields) == 0 {
+			return fields
+		}
+		if attr.Key == "" {
+			return append(fields, groupFields...)
+		}
+		return append(fields, Object(attr.Key, fieldObject(groupFields)))
+	default:
+		if err, ok := value.Any().(error); ok {
+			return append(fields, NamedErr(attr.Key, err))
+		}
+		return append(fields, Any(attr.Key, value.Any()))
+	}
+}
+
+// fieldObject 将一组字段编码为对象，用于输出分组
+type fieldObject []Field
+
+// MarshalLogObject 实现ObjectMarshaler
+func (o fieldObject) MarshalLogObject(enc ObjectEncoder) error {
+	for _, field := range o {
+		switch field.Type {
+		case StringType:
+			enc.AddString(field.Key, field.Str)
+		case Int64Type:
+			enc.AddInt64(field.Key, field.Integer)
+		case BoolType:
+			enc.AddBool(field.Key, field.Integer == 1)
+		case Float64Type:
+			enc.AddFloat64(field.Key, math.Float64frombits(uint64(field.Integer)))
+		case DurationType:
+			enc.AddDuration(field.Key, time.Duration(field.Integer))
+		case TimeType, TimeFullType:
+			enc.AddTime(field.Key, field.Any().(time.Time))
+		case ErrorType:
+			enc.AddString(field.Key, errorMessage(field.Value.(error)))
+		default:
+			if err := enc.AddReflected(field.Key, field.Any()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
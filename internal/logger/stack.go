@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxStackDepth 堆栈最多包含的栈帧数量
+const maxStackDepth = 64
+
+// StackKey 堆栈在日志中的字段名
+const StackKey = "stacktrace"
+
+// Stack 返回从调用日志门面的代码开始的调用栈，跳过规则与CallerFrame相同
+// 每个栈帧占两行：函数名，以及以制表符缩进的 "文件:行号"
+func Stack(skip int) string {
+	pcs := make([]uintptr, maxStackDepth+skip)
+	n := runtime.Callers(2, pcs)
+
+	var sb strings.Builder
+	appFrames(pcs[:n], skip, func(frame runtime.Frame) bool {
+		if sb.Len() > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(frame.Function)
+		sb.WriteString("\n\t")
+		sb.WriteString(frame.File)
+		sb.WriteByte(':')
+		sb.WriteString(strconv.Itoa(frame.Line))
+		return true
+	})
+	return sb.String()
+}
+
+// IndentStack 为堆栈的每一行加上缩进，供文本格式把堆栈输出为日志下方的缩进块
+func IndentStack(stack string, indent string) string {
+	return indent + strings.ReplaceAll(stack, "\n", "\n"+indent)
+}
+
+// WithStackTrace 设置为级别不低于level的日志附加调用堆栈
+// 单次调用可以使用Logger的WithStack方法
+func WithStackTrace(level LogLevel) Option {
+	return func(opt *LoggerOptions) {
+		opt.StackTrace = true
+		opt.StackLevel = level
+	}
+}
@@ -5,86 +5,144 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
+	"runtime"
+	"sync"
 	"time"
-
-	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // StdLogger 标准库log适配器
 type StdLogger struct {
-	level          LogLevel
-	fields         []Field
-	ctx            context.Context
-	logger         *log.Logger
-	name           string
-	format         string // 日志格式（text/json）
-	maxMessageSize int    // 单条日志最大大小（KB）
+	core   *stdCore     // 通过With系列方法和Named派生的实例共享同一个core
+	level  *AtomicLevel // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	fields []Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// stdCore 标准库日志可在运行时重新配置的状态
+type stdCore struct {
+	mu             sync.RWMutex
+	outputs        []stdOutput
+	closed         bool     // 已调用Close且之后没有重新配置
+	maxMessageSize int      // 单条日志最大大小（KB）
+	caller         bool     // 是否输出调用位置
+	callerSkip     int      // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool     // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     LogLevel // 附加堆栈的最低级别
+}
+
+// stdOutput 标准库日志的一个输出
+type stdOutput struct {
+	Output
+	logger *log.Logger
+	below  *log.Logger // 写入BelowLevelWriter，用于低于OverflowLevel的日志，队列已满时丢弃；为nil表示不需要
+}
+
+// loggerFor 返回写入级别为level的日志使用的实例
+func (o stdOutput) loggerFor(level LogLevel) *log.Logger {
+	if o.below != nil && o.BelowLevel(level) {
+		return o.below
+	}
+	return o.logger
 }
 
 // NewStdLogger 创建标准库log实例
 func NewStdLogger(name string, opts ...Option) *StdLogger {
-	options := &LoggerOptions{
-		Level:          InfoLevel,
-		Format:         "text",
-		OutputPath:     "stdout",
-		MaxLogSize:     100,                // 默认100MB
-		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
-		MaxLogFiles:    10,                 // 默认10个文件
-		CompressLogs:   false,              // 默认不压缩
-		MaxMessageSize: 0,                  // 默认不限制
-		Config:         make(map[string]interface{}),
+	options := NewLoggerOptions(opts...)
+	core := &stdCore{}
+	core.apply(options)
+
+	return &StdLogger{
+		core:   core,
+		level:  NewLoggerLevel(name, options),
+		fields: make([]Field, 0),
+		ctx:    context.Background(),
+		name:   name,
 	}
+}
 
-	for _, opt := range opts {
-		opt(options)
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *stdCore) apply(options *LoggerOptions) []Output {
+	outputs := ReplaceOutputs(c.currentOutputs(), options)
+	stdOutputs := make([]stdOutput, len(outputs))
+	for i, output := range outputs {
+		// 创建标准库log实例
+		stdOutputs[i] = stdOutput{Output: output, logger: log.New(output.Writer, "", log.LstdFlags)}
+		if below := output.BelowLevelWriter(); below != nil {
+			stdOutputs[i].below = log.New(below, "", log.LstdFlags)
+		}
 	}
 
-	// 配置输出
-	var output interface {
-		Write(p []byte) (n int, err error)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		old[i] = output.Output
 	}
-	if options.OutputPath == "stdout" {
-		output = os.Stdout
-	} else {
-		// 使用lumberjack进行日志轮转
-		output = &lumberjack.Logger{
-			Filename:   options.OutputPath,
-			MaxSize:    int(options.MaxLogSize),             // MB
-			MaxAge:     int(options.MaxLogAge.Hours() / 24), // 天
-			MaxBackups: options.MaxLogFiles,
-			Compress:   options.CompressLogs,
-		}
+	c.outputs = stdOutputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	return old
+}
+
+// currentOutputs 返回当前使用的所有输出
+func (c *stdCore) currentOutputs() []Output {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	outputs := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
 	}
+	return outputs
+}
 
-	// 创建标准库log实例
-	logger := log.New(output, "", log.LstdFlags)
+// close 标记为已关闭并关闭所有输出
+func (c *stdCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := make([]Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	c.mu.Unlock()
+	return CloseOutputs(outputs)
+}
 
-	return &StdLogger{
-		level:          options.Level,
-		fields:         make([]Field, 0),
-		ctx:            context.Background(),
-		logger:         logger,
-		name:           name,
-		format:         options.Format,
-		maxMessageSize: options.MaxMessageSize,
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (s *StdLogger) Reconfigure(opts ...Option) error {
+	options := NewLoggerOptions(opts...)
+	if options.AtomicLevel == nil {
+		s.level.SetLevel(options.Level)
 	}
+	old := s.core.apply(options)
+	return CloseOutputs(old)
 }
 
 // SetLevel 设置日志级别
 func (s *StdLogger) SetLevel(level LogLevel) {
-	s.level = level
+	s.level.SetLevel(level)
 }
 
 // GetLevel 获取当前日志级别
 func (s *StdLogger) GetLevel() LogLevel {
+	return s.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (s *StdLogger) AtomicLevel() *AtomicLevel {
 	return s.level
 }
 
 // limitMessageSize 限制日志消息大小
 func (s *StdLogger) limitMessageSize(msg string) string {
-	if s.maxMessageSize > 0 {
-		maxSize := s.maxMessageSize * 1024 // 转换为字节
+	if s.core.maxMessageSize > 0 {
+		maxSize := s.core.maxMessageSize * 1024 // 转换为字节
 		if len(msg) > maxSize {
 			return msg[:maxSize-3] + "..."
 		}
@@ -92,22 +150,34 @@ func (s *StdLogger) limitMessageSize(msg string) string {
 	return msg
 }
 
-// formatMessage 格式化日志消息
-func (s *StdLogger) formatMessage(level LogLevel, msg string, fields []Field) string {
+// formatMessage 格式化日志消息，caller为nil时不输出调用位置，stack为空时不输出堆栈
+// 文本格式的堆栈以缩进块的形式输出在日志下方，不计入单条日志大小的限制
+func (s *StdLogger) formatMessage(format string, level LogLevel, msg string, fields []Field, caller *runtime.Frame, stack string) string {
 	timestamp := time.Now().Format("2006-01-02 15:04:05.000")
-	allFields := append(s.fields, fields...)
+	allFields := ExpandErrors(AppendFields(s.fields, fields))
+	location := ""
+	if caller != nil {
+		location = " " + FormatCaller(*caller) + " " + ShortFunction(caller.Function)
+	}
 
-	if s.format == "json" {
+	if format == "json" {
 		// 构建JSON格式的日志
 		jsonFields := make(map[string]interface{})
 		jsonFields["time"] = timestamp
 		jsonFields["level"] = level.String()
 		jsonFields["logger"] = s.name
 		jsonFields["msg"] = msg
+		if caller != nil {
+			jsonFields["caller"] = FormatCaller(*caller)
+			jsonFields["func"] = caller.Function
+		}
+		if stack != "" {
+			jsonFields[StackKey] = stack
+		}
 
 		// 添加所有字段
 		for _, field := range allFields {
-			jsonFields[field.Key] = field.Value
+			jsonFields[field.Key] = field.Any()
 		}
 
 		// 转换为JSON字符串
@@ -116,10 +186,10 @@ func (s *StdLogger) formatMessage(level LogLevel, msg string, fields []Field) st
 			// 如果JSON转换失败，回退到文本格式
 			fieldStr := ""
 			for _, field := range allFields {
-				fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Value)
+				fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Any())
 			}
-			formattedMsg := fmt.Sprintf("[%s] [%s] %s%s", level.String(), s.name, msg, fieldStr)
-			return s.limitMessageSize(formattedMsg)
+			formattedMsg := fmt.Sprintf("[%s] [%s]%s %s%s", level.String(), s.name, location, msg, fieldStr)
+			return s.appendStack(s.limitMessageSize(formattedMsg), stack)
 		}
 
 		formattedMsg := string(jsonBytes)
@@ -128,114 +198,274 @@ func (s *StdLogger) formatMessage(level LogLevel, msg string, fields []Field) st
 		// 文本格式
 		fieldStr := ""
 		for _, field := range allFields {
-			fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Value)
+			fieldStr += fmt.Sprintf(" %s=%v", field.Key, field.Any())
 		}
 
-		formattedMsg := fmt.Sprintf("[%s] [%s] %s%s", level.String(), s.name, msg, fieldStr)
-		return s.limitMessageSize(formattedMsg)
+		formattedMsg := fmt.Sprintf("[%s] [%s]%s %s%s", level.String(), s.name, location, msg, fieldStr)
+		return s.appendStack(s.limitMessageSize(formattedMsg), stack)
+	}
+}
+
+// appendStack 在文本格式的日志下方追加缩进的堆栈
+func (s *StdLogger) appendStack(formatted string, stack string) string {
+	if stack == "" {
+		return formatted
+	}
+	return formatted + "\n" + IndentStack(stack, "\t")
+}
+
+// output 在级别启用时格式化并输出日志，返回格式化后的内容
+func (s *StdLogger) output(ctx context.Context, level LogLevel, msg string, fields []Field) string {
+	if !s.level.Enabled(level) {
+		return ""
+	}
+	fields = WithContextFields(ctx, fields)
+
+	s.core.mu.RLock()
+	defer s.core.mu.RUnlock()
+
+	var caller *runtime.Frame
+	if s.core.caller {
+		if frame, ok := CallerFrame(s.core.callerSkip); ok {
+			caller = &frame
+		}
+	}
+	stack := ""
+	if s.stack || (s.core.stackTrace && level.Severity() >= s.core.stackLevel.Severity()) {
+		stack = Stack(s.core.callerSkip)
+	}
+
+	// 每种格式只格式化一次，返回第一个输出的格式化结果
+	formatted := make(map[string]string, 1)
+	format := func(output Output) string {
+		message, ok := formatted[output.Format]
+		if !ok {
+			message = s.formatMessage(output.Format, level, msg, fields, caller, stack)
+			formatted[output.Format] = message
+		}
+		return message
+	}
+	for _, output := range s.core.outputs {
+		if output.Enabled(level) {
+			output.loggerFor(level).Println(format(output.Output))
+		}
+	}
+	return format(s.core.outputs[0].Output)
+}
+
+// Trace 输出跟踪级日志
+func (s *StdLogger) Trace(msg string, fields ...Field) {
+	s.output(s.ctx, TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (s *StdLogger) Tracef(format string, args ...interface{}) {
+	if s.IsTraceEnabled() {
+		s.output(s.ctx, TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if s.IsTraceEnabled() {
+		s.Trace(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Debug 输出调试级日志
 func (s *StdLogger) Debug(msg string, fields ...Field) {
-	if s.level <= DebugLevel {
-		s.logger.Println(s.formatMessage(DebugLevel, msg, fields))
-	}
+	s.output(s.ctx, DebugLevel, msg, fields)
 }
 
 // Debugf 输出格式化的调试级日志
 func (s *StdLogger) Debugf(format string, args ...interface{}) {
-	if s.level <= DebugLevel {
-		msg := fmt.Sprintf(format, args...)
-		s.logger.Println(s.formatMessage(DebugLevel, msg, nil))
+	if s.IsDebugEnabled() {
+		s.output(s.ctx, DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if s.IsDebugEnabled() {
+		s.Debug(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Info 输出信息级日志
 func (s *StdLogger) Info(msg string, fields ...Field) {
-	if s.level <= InfoLevel {
-		s.logger.Println(s.formatMessage(InfoLevel, msg, fields))
-	}
+	s.output(s.ctx, InfoLevel, msg, fields)
 }
 
 // Infof 输出格式化的信息级日志
 func (s *StdLogger) Infof(format string, args ...interface{}) {
-	if s.level <= InfoLevel {
-		msg := fmt.Sprintf(format, args...)
-		s.logger.Println(s.formatMessage(InfoLevel, msg, nil))
+	if s.IsInfoEnabled() {
+		s.output(s.ctx, InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if s.IsInfoEnabled() {
+		s.Info(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Warn 输出警告级日志
 func (s *StdLogger) Warn(msg string, fields ...Field) {
-	if s.level <= WarnLevel {
-		s.logger.Println(s.formatMessage(WarnLevel, msg, fields))
-	}
+	s.output(s.ctx, WarnLevel, msg, fields)
 }
 
 // Warnf 输出格式化的警告级日志
 func (s *StdLogger) Warnf(format string, args ...interface{}) {
-	if s.level <= WarnLevel {
-		msg := fmt.Sprintf(format, args...)
-		s.logger.Println(s.formatMessage(WarnLevel, msg, nil))
+	if s.IsWarnEnabled() {
+		s.output(s.ctx, WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if s.IsWarnEnabled() {
+		s.Warn(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Error 输出错误级日志
 func (s *StdLogger) Error(msg string, fields ...Field) {
-	if s.level <= ErrorLevel {
-		s.logger.Println(s.formatMessage(ErrorLevel, msg, fields))
-	}
+	s.output(s.ctx, ErrorLevel, msg, fields)
 }
 
 // Errorf 输出格式化的错误级日志
 func (s *StdLogger) Errorf(format string, args ...interface{}) {
-	if s.level <= ErrorLevel {
-		msg := fmt.Sprintf(format, args...)
-		s.logger.Println(s.formatMessage(ErrorLevel, msg, nil))
+	if s.IsErrorEnabled() {
+		s.output(s.ctx, ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if s.IsErrorEnabled() {
+		s.Error(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Fatal 输出致命级日志并退出程序
 func (s *StdLogger) Fatal(msg string, fields ...Field) {
-	if s.level <= FatalLevel {
-		s.logger.Println(s.formatMessage(FatalLevel, msg, fields))
-		os.Exit(1)
+	if s.IsFatalEnabled() {
+		s.output(s.ctx, FatalLevel, msg, fields)
+		s.exit()
 	}
 }
 
 // Fatalf 输出格式化的致命级日志并退出程序
 func (s *StdLogger) Fatalf(format string, args ...interface{}) {
-	if s.level <= FatalLevel {
-		msg := fmt.Sprintf(format, args...)
-		s.logger.Println(s.formatMessage(FatalLevel, msg, nil))
-		os.Exit(1)
+	if s.IsFatalEnabled() {
+		s.output(s.ctx, FatalLevel, fmt.Sprintf(format, args...), nil)
+		s.exit()
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (s *StdLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if s.IsFatalEnabled() {
+		s.Fatal(msg, KeyValueFields(keysAndValues)...)
 	}
 }
 
 // Panic 输出恐慌级日志并触发panic
 func (s *StdLogger) Panic(msg string, fields ...Field) {
-	if s.level <= PanicLevel {
-		msg := s.formatMessage(PanicLevel, msg, fields)
-		s.logger.Println(msg)
-		panic(msg)
+	if s.IsPanicEnabled() {
+		panic(s.output(s.ctx, PanicLevel, msg, fields))
 	}
 }
 
 // Panicf 输出格式化的恐慌级日志并触发panic
 func (s *StdLogger) Panicf(format string, args ...interface{}) {
-	if s.level <= PanicLevel {
-		msg := fmt.Sprintf(format, args...)
-		fullMsg := s.formatMessage(PanicLevel, msg, nil)
-		s.logger.Println(fullMsg)
-		panic(fullMsg)
+	if s.IsPanicEnabled() {
+		panic(s.output(s.ctx, PanicLevel, fmt.Sprintf(format, args...), nil))
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (s *StdLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if s.IsPanicEnabled() {
+		s.Panic(msg, KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (s *StdLogger) Log(level LogLevel, msg string, fields ...Field) {
+	s.LogContext(s.ctx, level, msg, fields...)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (s *StdLogger) Logf(level LogLevel, format string, args ...interface{}) {
+	if s.IsLevelEnabled(level) {
+		s.Log(level, fmt.Sprintf(format, args...))
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (s *StdLogger) Logw(level LogLevel, msg string, keysAndValues ...interface{}) {
+	if s.IsLevelEnabled(level) {
+		s.Log(level, msg, KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (s *StdLogger) TraceContext(ctx context.Context, msg string, fields ...Field) {
+	s.output(ctx, TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (s *StdLogger) DebugContext(ctx context.Context, msg string, fields ...Field) {
+	s.output(ctx, DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (s *StdLogger) InfoContext(ctx context.Context, msg string, fields ...Field) {
+	s.output(ctx, InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (s *StdLogger) WarnContext(ctx context.Context, msg string, fields ...Field) {
+	s.output(ctx, WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (s *StdLogger) ErrorContext(ctx context.Context, msg string, fields ...Field) {
+	s.output(ctx, ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (s *StdLogger) LogContext(ctx context.Context, level LogLevel, msg string, fields ...Field) {
+	switch level {
+	case FatalLevel:
+		if s.IsFatalEnabled() {
+			s.output(ctx, FatalLevel, msg, fields)
+			s.exit()
+		}
+	case PanicLevel:
+		if s.IsPanicEnabled() {
+			panic(s.output(ctx, PanicLevel, msg, fields))
+		}
+	default:
+		s.output(ctx, level, msg, fields)
 	}
 }
 
 // WithFields 添加字段到日志
 func (s *StdLogger) WithFields(fields ...Field) Logger {
 	newLogger := *s
-	newLogger.fields = append(newLogger.fields, fields...)
+	newLogger.fields = AppendFields(s.fields, fields)
+	return &newLogger
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (s *StdLogger) Named(name string) Logger {
+	newLogger := *s
+	newLogger.name = JoinLoggerName(s.name, name)
+	newLogger.level = NewNamedLevel(newLogger.name, s.level)
 	return &newLogger
 }
 
@@ -253,48 +483,85 @@ func (s *StdLogger) WithContext(ctx context.Context) Logger {
 
 // WithError 添加错误信息到日志
 func (s *StdLogger) WithError(err error) Logger {
-	return s.WithField("error", err)
+	return s.WithFields(Err(err))
 }
 
 // WithTime 添加时间到日志
 func (s *StdLogger) WithTime(t time.Time) Logger {
-	return s.WithField("time", t)
+	return s.WithFields(Time("time", t))
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (s *StdLogger) WithStack() Logger {
+	newLogger := *s
+	newLogger.stack = true
+	return &newLogger
+}
+
+// IsTraceEnabled 检查跟踪级别是否启用
+func (s *StdLogger) IsTraceEnabled() bool {
+	return s.level.Enabled(TraceLevel)
 }
 
 // IsDebugEnabled 检查调试级别是否启用
 func (s *StdLogger) IsDebugEnabled() bool {
-	return s.level <= DebugLevel
+	return s.level.Enabled(DebugLevel)
 }
 
 // IsInfoEnabled 检查信息级别是否启用
 func (s *StdLogger) IsInfoEnabled() bool {
-	return s.level <= InfoLevel
+	return s.level.Enabled(InfoLevel)
 }
 
 // IsWarnEnabled 检查警告级别是否启用
 func (s *StdLogger) IsWarnEnabled() bool {
-	return s.level <= WarnLevel
+	return s.level.Enabled(WarnLevel)
 }
 
 // IsErrorEnabled 检查错误级别是否启用
 func (s *StdLogger) IsErrorEnabled() bool {
-	return s.level <= ErrorLevel
+	return s.level.Enabled(ErrorLevel)
 }
 
 // IsFatalEnabled 检查致命级别是否启用
 func (s *StdLogger) IsFatalEnabled() bool {
-	return s.level <= FatalLevel
+	return s.level.Enabled(FatalLevel)
 }
 
 // IsPanicEnabled 检查恐慌级别是否启用
 func (s *StdLogger) IsPanicEnabled() bool {
-	return s.level <= PanicLevel
+	return s.level.Enabled(PanicLevel)
 }
 
-// Sync 刷新日志缓冲区
+// IsLevelEnabled 检查指定级别是否启用
+func (s *StdLogger) IsLevelEnabled(level LogLevel) bool {
+	return s.level.Enabled(level)
+}
+
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
 func (s *StdLogger) Sync() error {
-	// 标准库log没有Sync方法，返回nil
-	return nil
+	return SyncOutputs(s.core.currentOutputs())
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (s *StdLogger) Close() error {
+	return s.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (s *StdLogger) Closed() bool {
+	s.core.mu.RLock()
+	defer s.core.mu.RUnlock()
+	return s.core.closed
+}
+
+// exit 写入致命级日志后刷新输出并退出程序
+func (s *StdLogger) exit() {
+	if err := s.Sync(); err != nil {
+		ReportError(err)
+	}
+	Exit(1)
 }
 
 // StdLoggerProvider 标准库log提供者
@@ -311,72 +578,9 @@ func (p *StdLoggerProvider) Create(name string, opts ...Option) Logger {
 }
 
 // CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
 func (p *StdLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) Logger {
-	var level LogLevel
-	if lvl, ok := config["level"].(LogLevel); ok {
-		level = lvl
-	} else {
-		level = InfoLevel
-	}
-
-	var format string
-	if fmt, ok := config["format"].(string); ok {
-		format = fmt
-	} else {
-		format = "text"
-	}
-
-	var outputPath string
-	if path, ok := config["outputPath"].(string); ok {
-		outputPath = path
-	} else {
-		outputPath = "stdout"
-	}
-
-	var maxLogSize int64
-	if size, ok := config["maxLogSize"].(int64); ok {
-		maxLogSize = size
-	} else {
-		maxLogSize = 100
-	}
-
-	var maxLogAge time.Duration
-	if age, ok := config["maxLogAge"].(time.Duration); ok {
-		maxLogAge = age
-	} else {
-		maxLogAge = 7 * 24 * time.Hour
-	}
-
-	var maxLogFiles int
-	if files, ok := config["maxLogFiles"].(int); ok {
-		maxLogFiles = files
-	} else {
-		maxLogFiles = 10
-	}
-
-	var compressLogs bool
-	if compress, ok := config["compressLogs"].(bool); ok {
-		compressLogs = compress
-	} else {
-		compressLogs = false
-	}
-
-	var maxMessageSize int
-	if size, ok := config["maxMessageSize"].(int); ok {
-		maxMessageSize = size
-	} else {
-		maxMessageSize = 0
-	}
-
-	return NewStdLogger(name,
-		WithLevel(level),
-		WithFormat(format),
-		WithOutputPath(outputPath),
-		WithMaxLogSize(maxLogSize),
-		WithMaxLogAge(maxLogAge),
-		WithMaxLogFiles(maxLogFiles),
-		WithCompressLogs(compressLogs),
-		WithMaxMessageSize(maxMessageSize),
-		WithConfig(config),
-	)
+	opts, err := DecodeConfigMap(config)
+	ReportError(err)
+	return NewStdLogger(name, opts...)
 }
@@ -2,6 +2,11 @@
 package lclogface
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/LandcLi/landc-logface/internal/logger"
@@ -9,12 +14,30 @@ import (
 
 // 核心类型和函数导出
 
-// LogLevel 定义日志级别，支持 Debug、Info、Warn、Error、Fatal、Panic 六个级别
+// LogLevel 定义日志级别，支持 Trace、Debug、Info、Warn、Error、Fatal、Panic 七个内置级别和注册的自定义级别
 type LogLevel = logger.LogLevel
 
-// Field 定义日志字段，用于结构化日志
+// Field 定义日志字段，用于结构化日志，推荐使用String、Int64等构造函数创建以避免装箱
 type Field = logger.Field
 
+// FieldType 日志字段值的类型
+type FieldType = logger.FieldType
+
+// ObjectEncoder 编码对象字段的键值对
+type ObjectEncoder = logger.ObjectEncoder
+
+// ObjectMarshaler 可以将自身编码为对象的值，用于Object字段
+type ObjectMarshaler = logger.ObjectMarshaler
+
+// ArrayEncoder 编码数组字段的元素
+type ArrayEncoder = logger.ArrayEncoder
+
+// ArrayMarshaler 可以将自身编码为数组的值，用于Array字段
+type ArrayMarshaler = logger.ArrayMarshaler
+
+// LogFielder 可以提供自身结构化字段的错误，输出错误字段时这些字段会一起输出
+type LogFielder = logger.LogFielder
+
 // Logger 日志门面接口，定义了统一的日志方法
 type Logger = logger.Logger
 
@@ -27,11 +50,40 @@ type LoggerOptions = logger.LoggerOptions
 // LogConfig 统一的日志配置类，包含所有日志提供者的配置项
 type LogConfig = logger.LogConfig
 
+// OutputConfig 日志实例的一个输出，可以有自己的最低级别、格式和轮转配置
+type OutputConfig = logger.OutputConfig
+
+// OverflowPolicy 异步写入队列已满时的处理方式
+type OverflowPolicy = logger.OverflowPolicy
+
 // LoggerProvider 日志提供者接口
 type LoggerProvider = logger.LoggerProvider
 
-// 日志级别常量
+// AtomicLevel 可在任意goroutine中安全修改的日志级别，日志实例与其派生实例共享
+type AtomicLevel = logger.AtomicLevel
+
+// AtomicLeveler 可以返回自身AtomicLevel的日志实例
+type AtomicLeveler = logger.AtomicLeveler
+
+// LoggerInfo 日志工厂创建过的日志实例的信息
+type LoggerInfo = logger.LoggerInfo
+
+// Reconfigurable 支持在运行时重新配置级别、格式和输出的日志实例
+type Reconfigurable = logger.Reconfigurable
+
+// ConfigWatcher 配置文件监视器，文件变化时重新加载日志配置
+type ConfigWatcher = logger.ConfigWatcher
+
+// ErrorHandler 日志库内部错误的处理函数
+type ErrorHandler = logger.ErrorHandler
+
+// LoggingConfig 应用级日志配置文档，包含默认配置和按名称定义的日志实例
+type LoggingConfig = logger.LoggingConfig
+
+// 日志级别常量，Debug到Panic的数值依次为0到5，级别的先后按Severity比较，可以用RegisterLevel在其间注册自定义级别
 const (
+	// TraceLevel 跟踪级别日志，比调试级别更详细
+	TraceLevel LogLevel = logger.TraceLevel
 	// DebugLevel 调试级别日志
 	DebugLevel LogLevel = logger.DebugLevel
 	// InfoLevel 信息级别日志
@@ -46,13 +98,35 @@ const (
 	PanicLevel LogLevel = logger.PanicLevel
 )
 
+// 异步写入队列已满时的处理方式
+const (
+	// OverflowBlock 等待队列有空位，不丢弃日志
+	OverflowBlock OverflowPolicy = logger.OverflowBlock
+	// OverflowDropNewest 丢弃正在写入的日志
+	OverflowDropNewest OverflowPolicy = logger.OverflowDropNewest
+	// OverflowDropOldest 丢弃队列中最早的日志
+	OverflowDropOldest OverflowPolicy = logger.OverflowDropOldest
+	// OverflowDropBelowLevel 丢弃低于OverflowLevel的日志，不低于该级别的日志等待队列有空位
+	OverflowDropBelowLevel OverflowPolicy = logger.OverflowDropBelowLevel
+)
+
+// 配置错误
+var (
+	// ErrInvalidConfig 日志配置中存在非法的值
+	ErrInvalidConfig = logger.ErrInvalidConfig
+	// ErrUnknownProvider 日志提供者未注册
+	ErrUnknownProvider = logger.ErrUnknownProvider
+	// ErrInvalidKeyValues Infow等方法传入的键值对中存在缺少值或不是字符串的键
+	ErrInvalidKeyValues = logger.ErrInvalidKeyValues
+)
+
 // GetLogger 获取全局日志实例
 // 全局日志实例是一个默认的日志实例，可直接使用
 func GetLogger() Logger {
 	return logger.GetLogger()
 }
 
-// GetLoggerWithName 获取指定名称的日志实例
+// GetLoggerWithName 获取指定名称的日志实例，同一名称返回同一个实例，实例关闭后重新创建
 // name: 日志实例名称
 func GetLoggerWithName(name string) Logger {
 	return logger.GetLoggerWithName(name)
@@ -79,18 +153,224 @@ func GetLoggerWithLogConfig(config *LogConfig) Logger {
 	return logger.GetLoggerWithLogConfig(config)
 }
 
+// BuildLoggerWithProvider 使用指定的提供者创建日志实例
+// 与GetLoggerWithProvider不同，提供者未注册或选项非法时返回错误，不会回退到默认值
+// name: 日志实例名称
+// provider: 日志提供者名称
+// opts: 日志配置选项
+func BuildLoggerWithProvider(name string, provider string, opts ...Option) (Logger, error) {
+	return logger.BuildLoggerWithProvider(name, provider, opts...)
+}
+
+// BuildLoggerWithMap 根据配置map创建日志实例，配置值无法转换、非法或提供者未注册时返回错误
+// name: 日志实例名称
+// config: 配置map，包含各种配置项
+func BuildLoggerWithMap(name string, config map[string]interface{}) (Logger, error) {
+	return logger.BuildLoggerWithMap(name, config)
+}
+
+// BuildLoggerWithLogConfig 根据LogConfig创建日志实例
+// 与GetLoggerWithLogConfig不同，配置非法或提供者未注册时返回所有错误，不会修正配置
+// config: 统一的日志配置对象
+func BuildLoggerWithLogConfig(config *LogConfig) (Logger, error) {
+	return logger.BuildLoggerWithLogConfig(config)
+}
+
+// DecodeConfigMap 将配置map转换为选项，供自定义日志提供者的CreateWithConfig使用
+// 配置值可以是字符串或数字，如 "debug"、"100MB"、"24h"，无法转换的项会在返回的错误中列出
+// config: 配置map
+func DecodeConfigMap(config map[string]interface{}) ([]Option, error) {
+	return logger.DecodeConfigMap(config)
+}
+
+// SetStrict 设置全局日志工厂的严格模式
+// 严格模式下GetLogger系列函数遇到非法配置或未注册的提供者时会panic，而不是修正配置或回退到默认提供者
+// strict: 是否启用严格模式
+func SetStrict(strict bool) {
+	logger.SetStrict(strict)
+}
+
+// Loggers 返回全局日志工厂创建过的所有日志实例的名称、提供者和当前级别
+func Loggers() []LoggerInfo {
+	return logger.GetLogFactory().Loggers()
+}
+
+// LevelHandler 返回查看和修改日志级别的HTTP接口，作用于全局日志工厂创建过的日志实例
+// GET列出所有日志实例及其级别，可以用name参数只查看一个实例；
+// PUT修改级别，参数为name（省略时修改所有实例）、level和可选的ttl（如 "10m"，到期后恢复原级别），
+// 参数可以放在查询字符串中，也可以作为JSON请求体；多次调用返回同一个实例
+func LevelHandler() http.Handler {
+	return logger.GetLevelHandler()
+}
+
+// SetNamedLevel 为点分名称及其所有后代日志实例设置级别
+// 例如为 "app.db" 设置Debug后，"app.db" 和通过Named创建的 "app.db.pool" 等都会使用Debug，
+// 除非后代或更近的祖先也设置了级别；之后创建的同名或后代日志实例同样生效
+// name: 日志名称，空字符串表示所有日志实例
+// level: 日志级别
+func SetNamedLevel(name string, level LogLevel) {
+	logger.SetNamedLevel(name, level)
+}
+
+// UnsetNamedLevel 取消通过SetNamedLevel设置的级别，后代改为使用更远祖先上设置的级别
+// name: 日志名称
+func UnsetNamedLevel(name string) {
+	logger.UnsetNamedLevel(name)
+}
+
+// NamedLevel 返回名称自身或最近的祖先上通过SetNamedLevel设置的级别
+// name: 日志名称
+func NamedLevel(name string) (LogLevel, bool) {
+	return logger.GetLevelRegistry().Level(name)
+}
+
 // SetGlobalLogger 设置全局日志实例
 // log: 要设置的日志实例
 func SetGlobalLogger(log Logger) {
 	logger.SetGlobalLogger(log)
 }
 
+// Shutdown 刷新并关闭全局日志工厂创建的所有日志实例，通常在程序退出前调用
+// ctx结束时不再等待并返回ctx的错误，关闭后写入文件的日志会被丢弃
+// ctx: 控制最长等待时间的上下文
+func Shutdown(ctx context.Context) error {
+	return logger.Shutdown(ctx)
+}
+
+// Exit 刷新全局日志工厂创建的所有日志实例后退出程序，最多等待5秒，可代替os.Exit
+// code: 退出码
+func Exit(code int) {
+	logger.Exit(code)
+}
+
 // NewLogConfig 创建默认的日志配置
 // 返回一个带有默认值的LogConfig实例
 func NewLogConfig() *LogConfig {
 	return logger.NewLogConfig()
 }
 
+// 配置文件格式
+const (
+	// ConfigFormatJSON JSON格式配置文件
+	ConfigFormatJSON = logger.ConfigFormatJSON
+	// ConfigFormatYAML YAML格式配置文件
+	ConfigFormatYAML = logger.ConfigFormatYAML
+	// ConfigFormatTOML TOML格式配置文件
+	ConfigFormatTOML = logger.ConfigFormatTOML
+)
+
+// EnvPrefix 日志配置环境变量的前缀
+// 支持LANDC_LOG_LEVEL、LANDC_LOG_FORMAT、LANDC_LOG_OUTPUT以及按名称的LANDC_LOG_<NAME>_LEVEL等，
+// 日志工厂创建日志实例和重新加载配置时，环境变量中的值优先于代码、配置map和配置文件
+const EnvPrefix = logger.EnvPrefix
+
+// LoadConfigFile 从配置文件加载日志配置
+// path: 配置文件路径，根据扩展名（.json、.yaml/.yml、.toml）识别格式
+func LoadConfigFile(path string) (*LogConfig, error) {
+	return logger.LoadConfigFile(path)
+}
+
+// LoadConfig 从reader加载日志配置
+// r: 配置内容
+// format: 配置格式，如 ConfigFormatJSON、ConfigFormatYAML、ConfigFormatTOML
+func LoadConfig(r io.Reader, format string) (*LogConfig, error) {
+	return logger.LoadConfig(r, format)
+}
+
+// NewLoggingConfig 创建日志配置文档
+// 返回只包含默认配置的LoggingConfig实例
+func NewLoggingConfig() *LoggingConfig {
+	return logger.NewLoggingConfig()
+}
+
+// LoadLoggingConfigFile 从配置文件加载日志配置文档
+// path: 配置文件路径，根据扩展名识别格式
+func LoadLoggingConfigFile(path string) (*LoggingConfig, error) {
+	return logger.LoadLoggingConfigFile(path)
+}
+
+// LoadLoggingConfig 从reader加载日志配置文档
+// r: 配置内容
+// format: 配置格式，如 ConfigFormatJSON、ConfigFormatYAML、ConfigFormatTOML
+func LoadLoggingConfig(r io.Reader, format string) (*LoggingConfig, error) {
+	return logger.LoadLoggingConfig(r, format)
+}
+
+// Configure 使用配置文档配置全局日志工厂
+// 之后 GetLoggerWithName 将按名称使用文档中的定义
+// config: 日志配置文档
+func Configure(config *LoggingConfig) {
+	logger.Configure(config)
+}
+
+// ConfigureFromFile 从配置文件加载配置文档并配置全局日志工厂
+// path: 配置文件路径
+func ConfigureFromFile(path string) error {
+	config, err := logger.LoadLoggingConfigFile(path)
+	if err != nil {
+		return err
+	}
+	logger.Configure(config)
+	return nil
+}
+
+// ReloadConfig 使用新的配置文档重新配置全局日志工厂
+// 已经创建的日志实例会立即使用新的级别、格式和输出
+// config: 日志配置文档
+func ReloadConfig(config *LoggingConfig) error {
+	return logger.ReloadConfig(config)
+}
+
+// ApplyLogConfig 将配置应用到所有同名的已有日志实例
+// config: 日志配置，通过Name匹配日志实例
+func ApplyLogConfig(config *LogConfig) error {
+	return logger.ApplyLogConfig(config)
+}
+
+// WatchConfigFile 加载配置文件并监视其变化，文件变化时自动重新加载
+// path: 配置文件路径
+// interval: 检查间隔，小于等于0时使用默认的5秒
+func WatchConfigFile(path string, interval time.Duration) (*ConfigWatcher, error) {
+	return logger.WatchConfigFile(path, interval)
+}
+
+// SetErrorHandler 设置日志库内部错误的处理函数，默认输出到标准错误
+// handler: 错误处理函数，传入nil恢复默认处理
+func SetErrorHandler(handler ErrorHandler) {
+	logger.SetErrorHandler(handler)
+}
+
+// ParseLevel 将字符串解析为日志级别
+// text: 级别名称，如 "debug"、"INFO"，不区分大小写，也可以是已注册的自定义级别名称或级别的数值
+func ParseLevel(text string) (LogLevel, error) {
+	return logger.ParseLevel(text)
+}
+
+// RegisterLevel 注册自定义日志级别，注册后可以用Log方法输出，级别名称可以用于配置和ParseLevel
+// name: 级别名称，如 "NOTICE"，不区分大小写
+// severity: 严重程度，决定与其他级别的先后，如 InfoLevel.Severity()+5 介于Info和Warn之间
+func RegisterLevel(name string, severity int) (LogLevel, error) {
+	return logger.RegisterLevel(name, severity)
+}
+
+// MustRegisterLevel 注册自定义日志级别，失败时panic，适合初始化包级变量
+// name: 级别名称
+// severity: 严重程度
+func MustRegisterLevel(name string, severity int) LogLevel {
+	return logger.MustRegisterLevel(name, severity)
+}
+
+// CustomLevels 返回所有已注册的自定义级别，按严重程度从低到高排列
+func CustomLevels() []LogLevel {
+	return logger.CustomLevels()
+}
+
+// ParseDuration 解析可读的时间长度，支持 "7d"、"1w"、"24h" 等格式
+// text: 时间长度文本
+func ParseDuration(text string) (time.Duration, error) {
+	return logger.ParseDuration(text)
+}
+
 // 配置选项函数
 
 // WithLevel 设置日志级别
@@ -99,6 +379,18 @@ func WithLevel(level LogLevel) Option {
 	return logger.WithLevel(level)
 }
 
+// NewAtomicLevel 创建可共享的日志级别
+// level: 初始日志级别
+func NewAtomicLevel(level LogLevel) *AtomicLevel {
+	return logger.NewAtomicLevel(level)
+}
+
+// WithAtomicLevel 使用共享的日志级别创建日志实例，修改该级别会影响所有使用它的日志实例
+// level: 共享的日志级别
+func WithAtomicLevel(level *AtomicLevel) Option {
+	return logger.WithAtomicLevel(level)
+}
+
 // WithFormat 设置日志格式
 // format: 日志格式，支持 "text" 和 "json"
 func WithFormat(format string) Option {
@@ -111,6 +403,18 @@ func WithOutputPath(path string) Option {
 	return logger.WithOutputPath(path)
 }
 
+// WithOutputs 设置多个日志输出，日志同时写入所有级别满足要求的输出，设置后WithOutputPath不再生效
+// outputs: 输出配置，使用NewOutputConfig创建，未设置的格式和轮转配置使用日志实例的配置
+func WithOutputs(outputs ...OutputConfig) Option {
+	return logger.WithOutputs(outputs...)
+}
+
+// NewOutputConfig 创建输出配置
+// path: "stdout"、"stderr"或日志文件路径
+func NewOutputConfig(path string) OutputConfig {
+	return logger.NewOutputConfig(path)
+}
+
 // WithConfig 设置额外配置
 // config: 额外配置map，用于传递特定日志提供者的配置
 func WithConfig(config map[string]interface{}) Option {
@@ -141,14 +445,206 @@ func WithCompressLogs(compress bool) Option {
 	return logger.WithCompressLogs(compress)
 }
 
+// WithRotateInterval 设置按时间轮转的间隔，输出路径可以包含时间模式，如"logs/app-%Y%m%d-%H.log"
+// interval: 轮转间隔，如time.Hour每小时轮转、24*time.Hour每天轮转
+func WithRotateInterval(interval time.Duration) Option {
+	return logger.WithRotateInterval(interval)
+}
+
+// WithCurrentLink 设置指向当前日志文件的符号链接
+// link: 符号链接路径
+func WithCurrentLink(link string) Option {
+	return logger.WithCurrentLink(link)
+}
+
 // WithMaxMessageSize 设置单条日志最大大小（KB）
 // size: 单条日志最大大小，单位为KB
 func WithMaxMessageSize(size int) Option {
 	return logger.WithMaxMessageSize(size)
 }
 
+// WithAsync 开启异步写入，日志先放入有界队列，由后台协程批量写入，Sync会等待队列中的日志全部写入
+// queueSize: 队列容量（条），0表示同步写入
+func WithAsync(queueSize int) Option {
+	return logger.WithAsync(queueSize)
+}
+
+// WithOverflowPolicy 设置异步写入队列已满时的处理方式，默认为OverflowBlock
+// policy: 队列已满时的处理方式
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return logger.WithOverflowPolicy(policy)
+}
+
+// WithOverflowLevel 设置OverflowDropBelowLevel时不会被丢弃的最低级别，默认为WarnLevel
+// level: 不会被丢弃的最低级别
+func WithOverflowLevel(level LogLevel) Option {
+	return logger.WithOverflowLevel(level)
+}
+
+// DroppedEntries 返回进程内所有异步输出因队列已满丢弃的日志条数
+func DroppedEntries() uint64 {
+	return logger.DroppedEntries()
+}
+
+// ParseOverflowPolicy 解析异步写入队列已满时的处理方式，不区分大小写
+// text: 处理方式名称，如"drop_oldest"
+func ParseOverflowPolicy(text string) (OverflowPolicy, error) {
+	return logger.ParseOverflowPolicy(text)
+}
+
+// WithCaller 设置是否输出调用位置（文件、行号和函数名）
+// enabled: 是否输出调用位置
+func WithCaller(enabled bool) Option {
+	return logger.WithCaller(enabled)
+}
+
+// WithCallerSkip 设置输出调用位置时额外跳过的调用层数，应用封装了自己的日志函数时使用
+// skip: 在门面自身的调用层级之外额外跳过的层数
+func WithCallerSkip(skip int) Option {
+	return logger.WithCallerSkip(skip)
+}
+
+// StackKey 堆栈在日志中的字段名
+const StackKey = logger.StackKey
+
+// WithStackTrace 设置为级别不低于level的日志附加调用堆栈，单次调用可以使用Logger的WithStack方法
+// level: 附加堆栈的最低级别，如ErrorLevel
+func WithStackTrace(level LogLevel) Option {
+	return logger.WithStackTrace(level)
+}
+
+// 类型化字段
+
+// String 创建字符串字段
+// key: 字段名
+// value: 字段值
+func String(key string, value string) Field {
+	return logger.String(key, value)
+}
+
+// Int 创建整数字段
+// key: 字段名
+// value: 字段值
+func Int(key string, value int) Field {
+	return logger.Int(key, value)
+}
+
+// Int64 创建整数字段
+// key: 字段名
+// value: 字段值
+func Int64(key string, value int64) Field {
+	return logger.Int64(key, value)
+}
+
+// Float64 创建浮点数字段
+// key: 字段名
+// value: 字段值
+func Float64(key string, value float64) Field {
+	return logger.Float64(key, value)
+}
+
+// Bool 创建布尔字段
+// key: 字段名
+// value: 字段值
+func Bool(key string, value bool) Field {
+	return logger.Bool(key, value)
+}
+
+// Duration 创建时间长度字段
+// key: 字段名
+// value: 字段值
+func Duration(key string, value time.Duration) Field {
+	return logger.Duration(key, value)
+}
+
+// Time 创建时间字段
+// key: 字段名
+// value: 字段值
+func Time(key string, value time.Time) Field {
+	return logger.Time(key, value)
+}
+
+// Err 创建键为 "error" 的错误字段
+// err: 错误，为nil时字段值为nil
+func Err(err error) Field {
+	return logger.Err(err)
+}
+
+// NamedErr 创建指定键的错误字段
+// key: 字段名
+// err: 错误
+func NamedErr(key string, err error) Field {
+	return logger.NamedErr(key, err)
+}
+
+// ErrorFields 将错误展开为日志字段，日志提供者输出错误字段时使用相同的规则
+// key: 错误信息的字段名，错误类型和被包装的错误分别输出为key_type和key_causes
+// err: 错误
+func ErrorFields(key string, err error) []Field {
+	return logger.ErrorFields(key, err)
+}
+
+// BadKey 键值对中缺少值的键和不是字符串的键输出时使用的字段键
+const BadKey = logger.BadKey
+
+// KeyValueFields 将交替出现的键和值转换为字段，实现自定义日志提供者的Infow等方法时使用
+// 末尾缺少值的键和不是字符串的键以BadKey为键输出，问题交给错误处理函数
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func KeyValueFields(keysAndValues []interface{}) []Field {
+	return logger.KeyValueFields(keysAndValues)
+}
+
+// Stringer 创建在输出时才调用String方法的字段，日志被过滤时不会调用
+// key: 字段名
+// value: 实现了fmt.Stringer的值
+func Stringer(key string, value fmt.Stringer) Field {
+	return logger.Stringer(key, value)
+}
+
+// Object 创建对象字段
+// key: 字段名
+// value: 实现了ObjectMarshaler的值
+func Object(key string, value ObjectMarshaler) Field {
+	return logger.Object(key, value)
+}
+
+// Array 创建数组字段
+// key: 字段名
+// value: 实现了ArrayMarshaler的值
+func Array(key string, value ArrayMarshaler) Field {
+	return logger.Array(key, value)
+}
+
+// Any 创建任意类型的字段，与Field{Key: key, Value: value}相同
+// key: 字段名
+// value: 字段值
+func Any(key string, value interface{}) Field {
+	return logger.Any(key, value)
+}
+
 // 全局日志函数
 
+// Trace 全局跟踪级日志
+// msg: 日志消息
+// fields: 日志字段
+func Trace(msg string, fields ...Field) {
+	logger.Trace(msg, fields...)
+}
+
+// Tracef 全局格式化跟踪级日志
+// format: 格式化字符串
+// args: 格式化参数
+func Tracef(format string, args ...interface{}) {
+	logger.Tracef(format, args...)
+}
+
+// Tracew 全局跟踪级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Tracew(msg string, keysAndValues ...interface{}) {
+	logger.Tracew(msg, keysAndValues...)
+}
+
 // Debug 全局调试级日志
 // msg: 日志消息
 // fields: 日志字段
@@ -163,6 +659,13 @@ func Debugf(format string, args ...interface{}) {
 	logger.Debugf(format, args...)
 }
 
+// Debugw 全局调试级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Debugw(msg string, keysAndValues ...interface{}) {
+	logger.Debugw(msg, keysAndValues...)
+}
+
 // Info 全局信息级日志
 // msg: 日志消息
 // fields: 日志字段
@@ -177,6 +680,13 @@ func Infof(format string, args ...interface{}) {
 	logger.Infof(format, args...)
 }
 
+// Infow 全局信息级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Infow(msg string, keysAndValues ...interface{}) {
+	logger.Infow(msg, keysAndValues...)
+}
+
 // Warn 全局警告级日志
 // msg: 日志消息
 // fields: 日志字段
@@ -191,6 +701,13 @@ func Warnf(format string, args ...interface{}) {
 	logger.Warnf(format, args...)
 }
 
+// Warnw 全局警告级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Warnw(msg string, keysAndValues ...interface{}) {
+	logger.Warnw(msg, keysAndValues...)
+}
+
 // Error 全局错误级日志
 // msg: 日志消息
 // fields: 日志字段
@@ -205,6 +722,13 @@ func Errorf(format string, args ...interface{}) {
 	logger.Errorf(format, args...)
 }
 
+// Errorw 全局错误级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Errorw(msg string, keysAndValues ...interface{}) {
+	logger.Errorw(msg, keysAndValues...)
+}
+
 // Fatal 全局致命级日志，输出后程序会退出
 // msg: 日志消息
 // fields: 日志字段
@@ -219,6 +743,13 @@ func Fatalf(format string, args ...interface{}) {
 	logger.Fatalf(format, args...)
 }
 
+// Fatalw 全局致命级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Fatalw(msg string, keysAndValues ...interface{}) {
+	logger.Fatalw(msg, keysAndValues...)
+}
+
 // Panic 全局恐慌级日志，输出后会触发 panic
 // msg: 日志消息
 // fields: 日志字段
@@ -233,6 +764,196 @@ func Panicf(format string, args ...interface{}) {
 	logger.Panicf(format, args...)
 }
 
+// Panicw 全局恐慌级日志，使用交替出现的键和值作为字段
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Panicw(msg string, keysAndValues ...interface{}) {
+	logger.Panicw(msg, keysAndValues...)
+}
+
+// Log 全局按指定级别输出日志，可用于自定义级别
+// level: 日志级别
+// msg: 日志消息
+// fields: 日志字段
+func Log(level LogLevel, msg string, fields ...Field) {
+	logger.Log(level, msg, fields...)
+}
+
+// Logf 全局按指定级别输出格式化日志
+// level: 日志级别
+// format: 格式化字符串
+// args: 格式化参数
+func Logf(level LogLevel, format string, args ...interface{}) {
+	logger.Logf(level, format, args...)
+}
+
+// Logw 全局按指定级别输出日志，使用交替出现的键和值作为字段
+// level: 日志级别
+// msg: 日志消息
+// keysAndValues: 键和值，键必须是字符串，也可以直接传入Field
+func Logw(level LogLevel, msg string, keysAndValues ...interface{}) {
+	logger.Logw(level, msg, keysAndValues...)
+}
+
+// TraceContext 全局跟踪级日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// msg: 日志消息
+// fields: 日志字段
+func TraceContext(ctx context.Context, msg string, fields ...Field) {
+	logger.TraceContext(ctx, msg, fields...)
+}
+
+// DebugContext 全局调试级日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// msg: 日志消息
+// fields: 日志字段
+func DebugContext(ctx context.Context, msg string, fields ...Field) {
+	logger.DebugContext(ctx, msg, fields...)
+}
+
+// InfoContext 全局信息级日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// msg: 日志消息
+// fields: 日志字段
+func InfoContext(ctx context.Context, msg string, fields ...Field) {
+	logger.InfoContext(ctx, msg, fields...)
+}
+
+// WarnContext 全局警告级日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// msg: 日志消息
+// fields: 日志字段
+func WarnContext(ctx context.Context, msg string, fields ...Field) {
+	logger.WarnContext(ctx, msg, fields...)
+}
+
+// ErrorContext 全局错误级日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// msg: 日志消息
+// fields: 日志字段
+func ErrorContext(ctx context.Context, msg string, fields ...Field) {
+	logger.ErrorContext(ctx, msg, fields...)
+}
+
+// LogContext 全局按指定级别输出日志，并输出从ctx中提取的字段
+// ctx: 上下文
+// level: 日志级别
+// msg: 日志消息
+// fields: 日志字段
+func LogContext(ctx context.Context, level LogLevel, msg string, fields ...Field) {
+	logger.LogContext(ctx, level, msg, fields...)
+}
+
+// 上下文字段
+
+// ContextExtractor 从上下文中提取日志字段的函数
+type ContextExtractor = logger.ContextExtractor
+
+// 内置上下文数据的字段名
+const (
+	TraceIDKey   = logger.TraceIDKey
+	RequestIDKey = logger.RequestIDKey
+	UserIDKey    = logger.UserIDKey
+	TenantIDKey  = logger.TenantIDKey
+)
+
+// RegisterContextExtractor 注册上下文提取器，所有日志实例在输出时都会调用，同名的提取器会被替换
+// name: 提取器名称
+// extractor: 提取函数，上下文中没有相关数据时返回nil
+func RegisterContextExtractor(name string, extractor ContextExtractor) {
+	logger.RegisterContextExtractor(name, extractor)
+}
+
+// UnregisterContextExtractor 注销上下文提取器
+// name: 提取器名称
+func UnregisterContextExtractor(name string) {
+	logger.UnregisterContextExtractor(name)
+}
+
+// ContextKeyExtractor 创建读取ctx.Value(key)的提取器，值不为nil时输出名为field的字段
+// field: 输出的字段名
+// key: 上下文中的键
+func ContextKeyExtractor(field string, key interface{}) ContextExtractor {
+	return logger.ContextKeyExtractor(field, key)
+}
+
+// ContextFields 使用所有注册的提取器从上下文中提取字段
+// ctx: 上下文
+func ContextFields(ctx context.Context) []Field {
+	return logger.ContextFields(ctx)
+}
+
+// ContextWithTraceID 返回携带追踪ID的上下文，日志中输出为trace_id字段
+// ctx: 父上下文
+// traceID: 追踪ID
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return logger.ContextWithTraceID(ctx, traceID)
+}
+
+// TraceIDFromContext 获取上下文中的追踪ID
+// ctx: 上下文
+func TraceIDFromContext(ctx context.Context) string {
+	return logger.TraceIDFromContext(ctx)
+}
+
+// ContextWithRequestID 返回携带请求ID的上下文，日志中输出为request_id字段
+// ctx: 父上下文
+// requestID: 请求ID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return logger.ContextWithRequestID(ctx, requestID)
+}
+
+// RequestIDFromContext 获取上下文中的请求ID
+// ctx: 上下文
+func RequestIDFromContext(ctx context.Context) string {
+	return logger.RequestIDFromContext(ctx)
+}
+
+// ContextWithUserID 返回携带用户ID的上下文，日志中输出为user_id字段
+// ctx: 父上下文
+// userID: 用户ID
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return logger.ContextWithUserID(ctx, userID)
+}
+
+// UserIDFromContext 获取上下文中的用户ID
+// ctx: 上下文
+func UserIDFromContext(ctx context.Context) string {
+	return logger.UserIDFromContext(ctx)
+}
+
+// ContextWithTenantID 返回携带租户ID的上下文，日志中输出为tenant_id字段
+// ctx: 父上下文
+// tenantID: 租户ID
+func ContextWithTenantID(ctx context.Context, tenantID string) context.Context {
+	return logger.ContextWithTenantID(ctx, tenantID)
+}
+
+// TenantIDFromContext 获取上下文中的租户ID
+// ctx: 上下文
+func TenantIDFromContext(ctx context.Context) string {
+	return logger.TenantIDFromContext(ctx)
+}
+
+// NewContext 返回保存了日志实例的上下文，服务层可以通过FromContext取出，无需在函数签名中传递Logger
+// ctx: 父上下文
+// l: 日志实例
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return logger.NewContext(ctx, l)
+}
+
+// FromContext 返回上下文中保存的日志实例，没有时返回全局日志实例
+// ctx: 上下文
+func FromContext(ctx context.Context) Logger {
+	return logger.FromContext(ctx)
+}
+
+// LoggerFromContext 返回上下文中保存的日志实例，没有时返回false
+// ctx: 上下文
+func LoggerFromContext(ctx context.Context) (Logger, bool) {
+	return logger.LoggerFromContext(ctx)
+}
+
 // RegisterProvider 注册日志提供者
 // name: 提供者名称
 // provider: 日志提供者实例
@@ -245,3 +966,12 @@ func RegisterProvider(name string, provider LoggerProvider) {
 func UnregisterProvider(name string) {
 	logger.GetLogFactory().UnregisterProvider(name)
 }
+
+// log/slog
+
+// NewSlogHandler 创建输出到日志门面的slog.Handler，使slog的用户使用相同的日志提供者、格式和输出
+// 例如 slog.SetDefault(slog.New(LandcLogFace.NewSlogHandler(logger)))
+// l: 输出的日志实例，为nil时输出到全局日志实例
+func NewSlogHandler(l Logger) slog.Handler {
+	return logger.NewSlogHandler(l)
+}
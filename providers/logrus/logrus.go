@@ -3,99 +3,206 @@ package logrus
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
-	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/LandcLi/landc-logface/internal/logger"
 )
 
 // LogrusLogger logrus日志库适配器
 type LogrusLogger struct {
-	logger         *logrus.Logger
-	level          logger.LogLevel
-	fields         []logger.Field
-	ctx            context.Context
-	name           string
-	maxMessageSize int // 单条日志最大大小（KB）
+	core   *logrusCore         // 通过With系列方法和Named派生的实例共享同一个core
+	level  *logger.AtomicLevel // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	fields []logger.Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// logrusCore logrus日志可在运行时重新配置的状态
+type logrusCore struct {
+	mu             sync.RWMutex
+	outputs        []logrusOutput
+	closed         bool            // 已调用Close且之后没有重新配置
+	maxMessageSize int             // 单条日志最大大小（KB）
+	caller         bool            // 是否输出调用位置
+	callerSkip     int             // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool            // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     logger.LogLevel // 附加堆栈的最低级别
+}
+
+// logrusOutput logrus日志的一个输出，每个输出使用自己的logrus实例以设置格式
+type logrusOutput struct {
+	logger.Output
+	logger *logrus.Logger // 级别由各日志实例的AtomicLevel和输出的最低级别判断，logrus自身的级别始终放开
+	below  *logrus.Logger // 写入BelowLevelWriter，用于低于OverflowLevel的日志，队列已满时丢弃；为nil表示不需要
+}
+
+// loggerFor 返回写入级别为level的日志使用的logrus实例
+func (o logrusOutput) loggerFor(level logger.LogLevel) *logrus.Logger {
+	if o.below != nil && o.BelowLevel(level) {
+		return o.below
+	}
+	return o.logger
 }
 
 // NewLogrusLogger 创建logrus日志实例
 func NewLogrusLogger(name string, opts ...logger.Option) *LogrusLogger {
-	options := &logger.LoggerOptions{
-		Level:          logger.InfoLevel,
-		Format:         "text",
-		OutputPath:     "stdout",
-		MaxLogSize:     100,                // 默认100MB
-		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
-		MaxLogFiles:    10,                 // 默认10个文件
-		CompressLogs:   false,              // 默认不压缩
-		MaxMessageSize: 0,                  // 默认不限制
-		Config:         make(map[string]interface{}),
-	}
+	options := logger.NewLoggerOptions(opts...)
+	core := &logrusCore{}
+	core.apply(options)
 
-	for _, opt := range opts {
-		opt(options)
+	return &LogrusLogger{
+		core:  core,
+		level: logger.NewLoggerLevel(name, options),
+		name:  name,
 	}
+}
 
-	// 创建logrus实例
-	logrusLogger := logrus.New()
+// customLevelKey 自定义级别名称的字段名
+// logrus的级别是固定的，自定义级别按最近的内置级别输出，并通过该字段保留级别名称
+const customLevelKey = "custom_level"
 
-	// 设置日志级别
-	logrusLevel := logrus.InfoLevel
-	switch options.Level {
+// toLogrusLevel 将日志级别转换为logrus的级别，自定义级别转换为不高于它的最近的内置级别
+func toLogrusLevel(level logger.LogLevel) logrus.Level {
+	switch level {
+	case logger.TraceLevel:
+		return logrus.TraceLevel
 	case logger.DebugLevel:
-		logrusLevel = logrus.DebugLevel
+		return logrus.DebugLevel
 	case logger.InfoLevel:
-		logrusLevel = logrus.InfoLevel
+		return logrus.InfoLevel
 	case logger.WarnLevel:
-		logrusLevel = logrus.WarnLevel
+		return logrus.WarnLevel
 	case logger.ErrorLevel:
-		logrusLevel = logrus.ErrorLevel
-	case logger.FatalLevel, logger.PanicLevel:
-		logrusLevel = logrus.FatalLevel
+		return logrus.ErrorLevel
+	case logger.FatalLevel:
+		return logrus.FatalLevel
+	case logger.PanicLevel:
+		return logrus.PanicLevel
+	default:
+		return toLogrusLevel(logger.BaseLevel(level))
 	}
-	logrusLogger.SetLevel(logrusLevel)
+}
+
+// newLogrusInstance 创建写入writer的logrus实例，format为输出格式
+func newLogrusInstance(writer io.Writer, format string) *logrus.Logger {
+	// 创建logrus实例
+	logrusLogger := logrus.New()
+	logrusLogger.SetLevel(logrus.TraceLevel)
+	logrusLogger.SetOutput(writer)
+	logrusLogger.ExitFunc = logger.Exit
 
 	// 设置日志格式
-	if options.Format == "json" {
+	if format == "json" {
 		logrusLogger.SetFormatter(&logrus.JSONFormatter{
 			TimestampFormat: "2006-01-02 15:04:05",
 		})
 	} else {
-		logrusLogger.SetFormatter(&logrus.TextFormatter{
+		logrusLogger.SetFormatter(&textStackFormatter{&logrus.TextFormatter{
 			TimestampFormat: "2006-01-02 15:04:05",
 			FullTimestamp:   true,
-		})
+		}})
 	}
+	return logrusLogger
+}
 
-	// 设置输出
-	if options.OutputPath == "stdout" || options.OutputPath == "" {
-		logrusLogger.SetOutput(os.Stdout)
-	} else {
-		logrusLogger.SetOutput(&lumberjack.Logger{
-			Filename:   options.OutputPath,
-			MaxSize:    int(options.MaxLogSize),
-			MaxBackups: options.MaxLogFiles,
-			MaxAge:     int(options.MaxLogAge / (24 * time.Hour)),
-			Compress:   options.CompressLogs,
-		})
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *logrusCore) apply(options *logger.LoggerOptions) []logger.Output {
+	outputs := logger.ReplaceOutputs(c.currentOutputs(), options)
+	logrusOutputs := make([]logrusOutput, len(outputs))
+	for i, output := range outputs {
+		logrusOutputs[i] = logrusOutput{Output: output, logger: newLogrusInstance(output.Writer, output.Format)}
+		if below := output.BelowLevelWriter(); below != nil {
+			logrusOutputs[i].below = newLogrusInstance(below, output.Format)
+		}
 	}
 
-	return &LogrusLogger{
-		logger:         logrusLogger,
-		level:          options.Level,
-		name:           name,
-		maxMessageSize: options.MaxMessageSize,
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		old[i] = output.Output
+	}
+	c.outputs = logrusOutputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	return old
+}
+
+// textStackFormatter 文本格式下把堆栈从字段中取出，以缩进块的形式输出在日志下方
+type textStackFormatter struct {
+	*logrus.TextFormatter
+}
+
+// Format 实现logrus.Formatter
+func (f *textStackFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	stack, ok := entry.Data[logger.StackKey].(string)
+	if !ok {
+		return f.TextFormatter.Format(entry)
+	}
+
+	data := make(logrus.Fields, len(entry.Data))
+	for key, value := range entry.Data {
+		if key != logger.StackKey {
+			data[key] = value
+		}
+	}
+	withoutStack := *entry
+	withoutStack.Data = data
+	formatted, err := f.TextFormatter.Format(&withoutStack)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(formatted, logger.IndentStack(stack, "\t")...), '\n'), nil
+}
+
+// currentOutputs 返回当前使用的所有输出
+func (c *logrusCore) currentOutputs() []logger.Output {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
 	}
+	return outputs
+}
+
+// close 标记为已关闭并关闭所有输出
+func (c *logrusCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	c.mu.Unlock()
+	return logger.CloseOutputs(outputs)
+}
+
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (l *LogrusLogger) Reconfigure(opts ...logger.Option) error {
+	options := logger.NewLoggerOptions(opts...)
+	if options.AtomicLevel == nil {
+		l.level.SetLevel(options.Level)
+	}
+	old := l.core.apply(options)
+	return logger.CloseOutputs(old)
 }
 
 // limitMessageSize 限制日志消息大小
 func (l *LogrusLogger) limitMessageSize(msg string) string {
-	if l.maxMessageSize > 0 {
-		maxSize := l.maxMessageSize * 1024 // 转换为字节
+	if l.core.maxMessageSize > 0 {
+		maxSize := l.core.maxMessageSize * 1024 // 转换为字节
 		if len(msg) > maxSize {
 			return msg[:maxSize-3] + "..."
 		}
@@ -103,99 +210,261 @@ func (l *LogrusLogger) limitMessageSize(msg string) string {
 	return msg
 }
 
+// output 输出日志，实例上的fields和本次调用的fields都会被输出
+func (l *LogrusLogger) output(ctx context.Context, level logger.LogLevel, msg string, fields []logger.Field) {
+	if !l.level.Enabled(level) {
+		return
+	}
+	fields = logger.WithContextFields(ctx, fields)
+
+	l.core.mu.RLock()
+	data := l.convertFields(logger.AppendFields(l.fields, fields))
+	if l.name != "" {
+		data["logger"] = l.name
+	}
+	if logger.IsCustomLevel(level) {
+		data[customLevelKey] = strings.ToLower(level.String())
+	}
+	// 不使用logrus的ReportCaller，它只跳过logrus自身的栈帧，会把调用位置报告为本适配器
+	if l.core.caller {
+		if frame, ok := logger.CallerFrame(l.core.callerSkip); ok {
+			data[logrus.FieldKeyFile] = logger.FormatCaller(frame)
+			data[logrus.FieldKeyFunc] = frame.Function
+		}
+	}
+	if l.stack || (l.core.stackTrace && level.Severity() >= l.core.stackLevel.Severity()) {
+		data[logger.StackKey] = logger.Stack(l.core.callerSkip)
+	}
+
+	logrusLevel := toLogrusLevel(level)
+	msg = l.limitMessageSize(msg)
+	var panicked interface{}
+	for _, output := range l.core.outputs {
+		if output.Enabled(level) {
+			if p := logEntry(output.loggerFor(level).WithFields(data), logrusLevel, msg); panicked == nil {
+				panicked = p
+			}
+		}
+	}
+	exit := l.core.outputs[0].logger
+	l.core.mu.RUnlock()
+
+	switch {
+	case level == logger.FatalLevel:
+		// Entry.Log在Fatal级别不会退出程序，需要手动退出；先刷新输出，再运行logrus的退出处理函数并退出
+		if err := l.Sync(); err != nil {
+			logger.ReportError(err)
+		}
+		exit.Exit(1)
+	case logrusLevel == logrus.PanicLevel:
+		// 写入所有输出后再触发panic
+		if panicked == nil {
+			panicked = msg
+		}
+		panic(panicked)
+	}
+}
+
+// logEntry 输出日志，返回logrus在Panic级别写入后触发的panic值，使同一条日志能写入所有输出
+func logEntry(entry *logrus.Entry, level logrus.Level, msg string) (panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+	entry.Log(level, msg)
+	return nil
+}
+
+// Trace 输出跟踪级日志
+func (l *LogrusLogger) Trace(msg string, fields ...logger.Field) {
+	l.output(l.ctx, logger.TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (l *LogrusLogger) Tracef(format string, args ...interface{}) {
+	if l.IsTraceEnabled() {
+		l.output(l.ctx, logger.TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if l.IsTraceEnabled() {
+		l.Trace(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
 // Debug 输出调试级日志
 func (l *LogrusLogger) Debug(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Debug(msg)
+	l.output(l.ctx, logger.DebugLevel, msg, fields)
 }
 
 // Debugf 输出格式化的调试级日志
 func (l *LogrusLogger) Debugf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Debugf(msg)
+	if l.IsDebugEnabled() {
+		l.output(l.ctx, logger.DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if l.IsDebugEnabled() {
+		l.Debug(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Info 输出信息级日志
 func (l *LogrusLogger) Info(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Info(msg)
+	l.output(l.ctx, logger.InfoLevel, msg, fields)
 }
 
 // Infof 输出格式化的信息级日志
 func (l *LogrusLogger) Infof(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Infof(msg)
+	if l.IsInfoEnabled() {
+		l.output(l.ctx, logger.InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if l.IsInfoEnabled() {
+		l.Info(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Warn 输出警告级日志
 func (l *LogrusLogger) Warn(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Warn(msg)
+	l.output(l.ctx, logger.WarnLevel, msg, fields)
 }
 
 // Warnf 输出格式化的警告级日志
 func (l *LogrusLogger) Warnf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Warnf(msg)
+	if l.IsWarnEnabled() {
+		l.output(l.ctx, logger.WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if l.IsWarnEnabled() {
+		l.Warn(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Error 输出错误级日志
 func (l *LogrusLogger) Error(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Error(msg)
+	l.output(l.ctx, logger.ErrorLevel, msg, fields)
 }
 
 // Errorf 输出格式化的错误级日志
 func (l *LogrusLogger) Errorf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Errorf(msg)
+	if l.IsErrorEnabled() {
+		l.output(l.ctx, logger.ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if l.IsErrorEnabled() {
+		l.Error(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Fatal 输出致命级日志并退出程序
 func (l *LogrusLogger) Fatal(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Fatal(msg)
+	l.output(l.ctx, logger.FatalLevel, msg, fields)
 }
 
 // Fatalf 输出格式化的致命级日志并退出程序
 func (l *LogrusLogger) Fatalf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Fatalf(msg)
+	if l.IsFatalEnabled() {
+		l.output(l.ctx, logger.FatalLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if l.IsFatalEnabled() {
+		l.Fatal(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Panic 输出恐慌级日志并触发panic
 func (l *LogrusLogger) Panic(msg string, fields ...logger.Field) {
-	msg = l.limitMessageSize(msg)
-	entry := l.logger.WithFields(l.convertFields(append(l.fields, fields...)))
-	entry.Panic(msg)
+	l.output(l.ctx, logger.PanicLevel, msg, fields)
 }
 
 // Panicf 输出格式化的恐慌级日志并触发panic
 func (l *LogrusLogger) Panicf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = l.limitMessageSize(msg)
-	l.logger.Panicf(msg)
+	if l.IsPanicEnabled() {
+		l.output(l.ctx, logger.PanicLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if l.IsPanicEnabled() {
+		l.Panic(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (l *LogrusLogger) Log(level logger.LogLevel, msg string, fields ...logger.Field) {
+	l.output(l.ctx, level, msg, fields)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (l *LogrusLogger) Logf(level logger.LogLevel, format string, args ...interface{}) {
+	if l.IsLevelEnabled(level) {
+		l.output(l.ctx, level, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (l *LogrusLogger) Logw(level logger.LogLevel, msg string, keysAndValues ...interface{}) {
+	if l.IsLevelEnabled(level) {
+		l.Log(level, msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) TraceContext(ctx context.Context, msg string, fields ...logger.Field) {
+	l.output(ctx, logger.TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) DebugContext(ctx context.Context, msg string, fields ...logger.Field) {
+	l.output(ctx, logger.DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) InfoContext(ctx context.Context, msg string, fields ...logger.Field) {
+	l.output(ctx, logger.InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) WarnContext(ctx context.Context, msg string, fields ...logger.Field) {
+	l.output(ctx, logger.WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) ErrorContext(ctx context.Context, msg string, fields ...logger.Field) {
+	l.output(ctx, logger.ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (l *LogrusLogger) LogContext(ctx context.Context, level logger.LogLevel, msg string, fields ...logger.Field) {
+	l.output(ctx, level, msg, fields)
 }
 
 // WithFields 添加字段到日志
 func (l *LogrusLogger) WithFields(fields ...logger.Field) logger.Logger {
 	return &LogrusLogger{
-		logger:         l.logger,
-		level:          l.level,
-		fields:         append(l.fields, fields...),
-		ctx:            l.ctx,
-		name:           l.name,
-		maxMessageSize: l.maxMessageSize,
+		core:   l.core,
+		level:  l.level,
+		fields: logger.AppendFields(l.fields, fields),
+		ctx:    l.ctx,
+		name:   l.name,
+		stack:  l.stack,
 	}
 }
 
@@ -207,89 +476,129 @@ func (l *LogrusLogger) WithField(key string, value interface{}) logger.Logger {
 // WithContext 添加上下文到日志
 func (l *LogrusLogger) WithContext(ctx context.Context) logger.Logger {
 	return &LogrusLogger{
-		logger:         l.logger,
-		level:          l.level,
-		fields:         l.fields,
-		ctx:            ctx,
-		name:           l.name,
-		maxMessageSize: l.maxMessageSize,
+		core:   l.core,
+		level:  l.level,
+		fields: l.fields,
+		ctx:    ctx,
+		name:   l.name,
+		stack:  l.stack,
+	}
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (l *LogrusLogger) Named(name string) logger.Logger {
+	fullName := logger.JoinLoggerName(l.name, name)
+	return &LogrusLogger{
+		core:   l.core,
+		level:  logger.NewNamedLevel(fullName, l.level),
+		fields: l.fields,
+		ctx:    l.ctx,
+		name:   fullName,
+		stack:  l.stack,
+	}
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (l *LogrusLogger) WithStack() logger.Logger {
+	return &LogrusLogger{
+		core:   l.core,
+		level:  l.level,
+		fields: l.fields,
+		ctx:    l.ctx,
+		name:   l.name,
+		stack:  true,
 	}
 }
 
 // WithError 添加错误信息到日志
 func (l *LogrusLogger) WithError(err error) logger.Logger {
-	return l.WithField("error", err)
+	return l.WithFields(logger.Err(err))
 }
 
 // WithTime 添加时间到日志
 func (l *LogrusLogger) WithTime(t time.Time) logger.Logger {
-	return l.WithField("time", t)
+	return l.WithFields(logger.Time("time", t))
 }
 
 // SetLevel 设置日志级别
 func (l *LogrusLogger) SetLevel(level logger.LogLevel) {
-	l.level = level
-	logrusLevel := logrus.InfoLevel
-	switch level {
-	case logger.DebugLevel:
-		logrusLevel = logrus.DebugLevel
-	case logger.InfoLevel:
-		logrusLevel = logrus.InfoLevel
-	case logger.WarnLevel:
-		logrusLevel = logrus.WarnLevel
-	case logger.ErrorLevel:
-		logrusLevel = logrus.ErrorLevel
-	case logger.FatalLevel, logger.PanicLevel:
-		logrusLevel = logrus.FatalLevel
-	}
-	l.logger.SetLevel(logrusLevel)
+	l.level.SetLevel(level)
 }
 
 // GetLevel 获取日志级别
 func (l *LogrusLogger) GetLevel() logger.LogLevel {
+	return l.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (l *LogrusLogger) AtomicLevel() *logger.AtomicLevel {
 	return l.level
 }
 
+// IsTraceEnabled 检查跟踪级别是否启用
+func (l *LogrusLogger) IsTraceEnabled() bool {
+	return l.level.Enabled(logger.TraceLevel)
+}
+
 // IsDebugEnabled 检查调试级别是否启用
 func (l *LogrusLogger) IsDebugEnabled() bool {
-	return l.level <= logger.DebugLevel
+	return l.level.Enabled(logger.DebugLevel)
 }
 
 // IsInfoEnabled 检查信息级别是否启用
 func (l *LogrusLogger) IsInfoEnabled() bool {
-	return l.level <= logger.InfoLevel
+	return l.level.Enabled(logger.InfoLevel)
 }
 
 // IsWarnEnabled 检查警告级别是否启用
 func (l *LogrusLogger) IsWarnEnabled() bool {
-	return l.level <= logger.WarnLevel
+	return l.level.Enabled(logger.WarnLevel)
 }
 
 // IsErrorEnabled 检查错误级别是否启用
 func (l *LogrusLogger) IsErrorEnabled() bool {
-	return l.level <= logger.ErrorLevel
+	return l.level.Enabled(logger.ErrorLevel)
 }
 
 // IsFatalEnabled 检查致命级别是否启用
 func (l *LogrusLogger) IsFatalEnabled() bool {
-	return l.level <= logger.FatalLevel
+	return l.level.Enabled(logger.FatalLevel)
 }
 
 // IsPanicEnabled 检查恐慌级别是否启用
 func (l *LogrusLogger) IsPanicEnabled() bool {
-	return l.level <= logger.PanicLevel
+	return l.level.Enabled(logger.PanicLevel)
+}
+
+// IsLevelEnabled 检查指定级别是否启用
+func (l *LogrusLogger) IsLevelEnabled(level logger.LogLevel) bool {
+	return l.level.Enabled(level)
 }
 
-// Sync 刷新日志缓冲区
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
 func (l *LogrusLogger) Sync() error {
-	return nil
+	return logger.SyncOutputs(l.core.currentOutputs())
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (l *LogrusLogger) Close() error {
+	return l.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (l *LogrusLogger) Closed() bool {
+	l.core.mu.RLock()
+	defer l.core.mu.RUnlock()
+	return l.core.closed
 }
 
 // convertFields 转换字段
 func (l *LogrusLogger) convertFields(fields []logger.Field) logrus.Fields {
 	logrusFields := make(logrus.Fields)
-	for _, field := range fields {
-		logrusFields[field.Key] = field.Value
+	for _, field := range logger.ExpandErrors(fields) {
+		logrusFields[field.Key] = field.Any()
 	}
 	return logrusFields
 }
@@ -308,34 +617,10 @@ func (p *LogrusLoggerProvider) Create(name string, opts ...logger.Option) logger
 }
 
 // CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
 func (p *LogrusLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) logger.Logger {
-	opts := make([]logger.Option, 0)
-
-	if level, ok := config["level"].(logger.LogLevel); ok {
-		opts = append(opts, logger.WithLevel(level))
-	}
-	if format, ok := config["format"].(string); ok {
-		opts = append(opts, logger.WithFormat(format))
-	}
-	if outputPath, ok := config["outputPath"].(string); ok {
-		opts = append(opts, logger.WithOutputPath(outputPath))
-	}
-	if maxLogSize, ok := config["maxLogSize"].(int64); ok {
-		opts = append(opts, logger.WithMaxLogSize(maxLogSize))
-	}
-	if maxLogAge, ok := config["maxLogAge"].(time.Duration); ok {
-		opts = append(opts, logger.WithMaxLogAge(maxLogAge))
-	}
-	if maxLogFiles, ok := config["maxLogFiles"].(int); ok {
-		opts = append(opts, logger.WithMaxLogFiles(maxLogFiles))
-	}
-	if compressLogs, ok := config["compressLogs"].(bool); ok {
-		opts = append(opts, logger.WithCompressLogs(compressLogs))
-	}
-	if maxMessageSize, ok := config["maxMessageSize"].(int); ok {
-		opts = append(opts, logger.WithMaxMessageSize(maxMessageSize))
-	}
-
+	opts, err := logger.DecodeConfigMap(config)
+	logger.ReportError(err)
 	return NewLogrusLogger(name, opts...)
 }
 
@@ -0,0 +1,651 @@
+package slog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/LandcLi/landc-logface/internal/logger"
+)
+
+// SlogLogger 基于标准库log/slog的日志适配器，不依赖第三方日志库
+type SlogLogger struct {
+	core   *slogCore           // 通过With系列方法和Named派生的实例共享同一个core
+	level  *logger.AtomicLevel // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	fields []logger.Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// slogCore slog日志可在运行时重新配置的状态
+type slogCore struct {
+	mu             sync.RWMutex
+	writeMu        sync.Mutex // 保证日志和文本格式的堆栈块连续写入
+	outputs        []slogOutput
+	closed         bool            // 已调用Close且之后没有重新配置
+	maxMessageSize int             // 单条日志最大大小（KB）
+	caller         bool            // 是否输出调用位置
+	callerSkip     int             // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool            // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     logger.LogLevel // 附加堆栈的最低级别
+}
+
+// slogOutput slog日志的一个输出
+type slogOutput struct {
+	logger.Output
+	handler slog.Handler // 级别由各日志实例的AtomicLevel和输出的最低级别判断，handler本身不做过滤
+	below   slog.Handler // 写入BelowLevelWriter，用于低于OverflowLevel的日志，队列已满时丢弃；为nil表示不需要
+}
+
+// handlerFor 返回写入级别为level的日志使用的handler及其输出
+func (o slogOutput) handlerFor(level logger.LogLevel) (slog.Handler, io.Writer) {
+	if o.below != nil && o.BelowLevel(level) {
+		return o.below, o.BelowLevelWriter()
+	}
+	return o.handler, o.Writer
+}
+
+// NewSlogLogger 创建slog日志实例
+func NewSlogLogger(name string, opts ...logger.Option) *SlogLogger {
+	options := logger.NewLoggerOptions(opts...)
+	core := &slogCore{}
+	core.apply(options)
+
+	return &SlogLogger{
+		core:  core,
+		level: logger.NewLoggerLevel(name, options),
+		ctx:   context.Background(),
+		name:  name,
+	}
+}
+
+// newSlogHandler 创建写入writer的handler，format为输出格式
+func newSlogHandler(writer io.Writer, format string, options *slog.HandlerOptions) slog.Handler {
+	if format == "json" {
+		return slog.NewJSONHandler(writer, options)
+	}
+	return slog.NewTextHandler(writer, options)
+}
+
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *slogCore) apply(options *logger.LoggerOptions) []logger.Output {
+	// 日志级别的数值直接作为slog的级别，输出时由replaceAttr还原名称
+	handlerOptions := &slog.HandlerOptions{
+		Level:       slog.Level(math.MinInt),
+		ReplaceAttr: replaceAttr,
+	}
+
+	outputs := logger.ReplaceOutputs(c.currentOutputs(), options)
+	slogOutputs := make([]slogOutput, len(outputs))
+	for i, output := range outputs {
+		slogOutputs[i] = slogOutput{Output: output, handler: newSlogHandler(output.Writer, output.Format, handlerOptions)}
+		if below := output.BelowLevelWriter(); below != nil {
+			slogOutputs[i].below = newSlogHandler(below, output.Format, handlerOptions)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		old[i] = output.Output
+	}
+	c.outputs = slogOutputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	return old
+}
+
+// replaceAttr 以门面的名称输出级别，包括跟踪级别和自定义级别
+func replaceAttr(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) == 0 && attr.Key == slog.LevelKey {
+		if level, ok := attr.Value.Any().(slog.Level); ok {
+			return slog.String(slog.LevelKey, logger.LogLevel(level).String())
+		}
+	}
+	return attr
+}
+
+// currentOutputs 返回当前使用的所有输出
+func (c *slogCore) currentOutputs() []logger.Output {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	return outputs
+}
+
+// close 标记为已关闭并关闭所有输出
+func (c *slogCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	c.mu.Unlock()
+	return logger.CloseOutputs(outputs)
+}
+
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (s *SlogLogger) Reconfigure(opts ...logger.Option) error {
+	options := logger.NewLoggerOptions(opts...)
+	if options.AtomicLevel == nil {
+		s.level.SetLevel(options.Level)
+	}
+	old := s.core.apply(options)
+	return logger.CloseOutputs(old)
+}
+
+// limitMessageSize 限制日志消息大小
+func (s *SlogLogger) limitMessageSize(msg string) string {
+	if s.core.maxMessageSize > 0 {
+		maxSize := s.core.maxMessageSize * 1024 // 转换为字节
+		if len(msg) > maxSize {
+			return msg[:maxSize-3] + "..."
+		}
+	}
+	return msg
+}
+
+// output 输出日志，Fatal级别输出后退出程序，Panic级别输出后触发panic
+func (s *SlogLogger) output(ctx context.Context, level logger.LogLevel, msg string, fields []logger.Field) {
+	if !s.level.Enabled(level) {
+		return
+	}
+	fields = logger.WithContextFields(ctx, fields)
+
+	s.core.mu.RLock()
+	record := slog.NewRecord(time.Now(), slog.Level(level), s.limitMessageSize(msg), 0)
+	if s.name != "" {
+		record.AddAttrs(slog.String("logger", s.name))
+	}
+	if s.core.caller {
+		if frame, ok := logger.CallerFrame(s.core.callerSkip); ok {
+			record.AddAttrs(slog.String("caller", logger.FormatCaller(frame)), slog.String("func", frame.Function))
+		}
+	}
+	record.AddAttrs(convertFields(logger.AppendFields(s.fields, fields))...)
+	stack := ""
+	if s.stack || (s.core.stackTrace && level.Severity() >= s.core.stackLevel.Severity()) {
+		stack = logger.Stack(s.core.callerSkip)
+	}
+	s.core.write(ctx, level, record, stack)
+	s.core.mu.RUnlock()
+
+	switch level {
+	case logger.FatalLevel:
+		if err := s.Sync(); err != nil {
+			logger.ReportError(err)
+		}
+		logger.Exit(1)
+	case logger.PanicLevel:
+		panic(msg)
+	}
+}
+
+// write 将日志记录写入级别满足要求的输出，json格式的堆栈作为字段输出，文本格式的堆栈以缩进块的形式写在日志下方
+func (c *slogCore) write(ctx context.Context, level logger.LogLevel, record slog.Record, stack string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	for _, output := range c.outputs {
+		if !output.Enabled(level) {
+			continue
+		}
+		handler, writer := output.handlerFor(level)
+		outputRecord := record
+		if stack != "" && output.Format == "json" {
+			outputRecord = record.Clone()
+			outputRecord.AddAttrs(slog.String(logger.StackKey, stack))
+		}
+		if err := handler.Handle(ctx, outputRecord); err != nil {
+			logger.ReportError(fmt.Errorf("slog: %w", err))
+			continue
+		}
+		if stack != "" && output.Format != "json" {
+			if _, err := io.WriteString(writer, logger.IndentStack(stack, "\t")+"\n"); err != nil {
+				logger.ReportError(fmt.Errorf("slog: %w", err))
+			}
+		}
+	}
+}
+
+// Trace 输出跟踪级日志
+func (s *SlogLogger) Trace(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (s *SlogLogger) Tracef(format string, args ...interface{}) {
+	if s.IsTraceEnabled() {
+		s.output(s.ctx, logger.TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if s.IsTraceEnabled() {
+		s.Trace(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Debug 输出调试级日志
+func (s *SlogLogger) Debug(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.DebugLevel, msg, fields)
+}
+
+// Debugf 输出格式化的调试级日志
+func (s *SlogLogger) Debugf(format string, args ...interface{}) {
+	if s.IsDebugEnabled() {
+		s.output(s.ctx, logger.DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if s.IsDebugEnabled() {
+		s.Debug(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Info 输出信息级日志
+func (s *SlogLogger) Info(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.InfoLevel, msg, fields)
+}
+
+// Infof 输出格式化的信息级日志
+func (s *SlogLogger) Infof(format string, args ...interface{}) {
+	if s.IsInfoEnabled() {
+		s.output(s.ctx, logger.InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if s.IsInfoEnabled() {
+		s.Info(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Warn 输出警告级日志
+func (s *SlogLogger) Warn(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.WarnLevel, msg, fields)
+}
+
+// Warnf 输出格式化的警告级日志
+func (s *SlogLogger) Warnf(format string, args ...interface{}) {
+	if s.IsWarnEnabled() {
+		s.output(s.ctx, logger.WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if s.IsWarnEnabled() {
+		s.Warn(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Error 输出错误级日志
+func (s *SlogLogger) Error(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.ErrorLevel, msg, fields)
+}
+
+// Errorf 输出格式化的错误级日志
+func (s *SlogLogger) Errorf(format string, args ...interface{}) {
+	if s.IsErrorEnabled() {
+		s.output(s.ctx, logger.ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if s.IsErrorEnabled() {
+		s.Error(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Fatal 输出致命级日志并退出程序
+func (s *SlogLogger) Fatal(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.FatalLevel, msg, fields)
+}
+
+// Fatalf 输出格式化的致命级日志并退出程序
+func (s *SlogLogger) Fatalf(format string, args ...interface{}) {
+	if s.IsFatalEnabled() {
+		s.output(s.ctx, logger.FatalLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if s.IsFatalEnabled() {
+		s.Fatal(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Panic 输出恐慌级日志并触发panic
+func (s *SlogLogger) Panic(msg string, fields ...logger.Field) {
+	s.output(s.ctx, logger.PanicLevel, msg, fields)
+}
+
+// Panicf 输出格式化的恐慌级日志并触发panic
+func (s *SlogLogger) Panicf(format string, args ...interface{}) {
+	if s.IsPanicEnabled() {
+		s.output(s.ctx, logger.PanicLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if s.IsPanicEnabled() {
+		s.Panic(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (s *SlogLogger) Log(level logger.LogLevel, msg string, fields ...logger.Field) {
+	s.output(s.ctx, level, msg, fields)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (s *SlogLogger) Logf(level logger.LogLevel, format string, args ...interface{}) {
+	if s.IsLevelEnabled(level) {
+		s.output(s.ctx, level, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (s *SlogLogger) Logw(level logger.LogLevel, msg string, keysAndValues ...interface{}) {
+	if s.IsLevelEnabled(level) {
+		s.Log(level, msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (s *SlogLogger) TraceContext(ctx context.Context, msg string, fields ...logger.Field) {
+	s.output(ctx, logger.TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (s *SlogLogger) DebugContext(ctx context.Context, msg string, fields ...logger.Field) {
+	s.output(ctx, logger.DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (s *SlogLogger) InfoContext(ctx context.Context, msg string, fields ...logger.Field) {
+	s.output(ctx, logger.InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (s *SlogLogger) WarnContext(ctx context.Context, msg string, fields ...logger.Field) {
+	s.output(ctx, logger.WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (s *SlogLogger) ErrorContext(ctx context.Context, msg string, fields ...logger.Field) {
+	s.output(ctx, logger.ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (s *SlogLogger) LogContext(ctx context.Context, level logger.LogLevel, msg string, fields ...logger.Field) {
+	s.output(ctx, level, msg, fields)
+}
+
+// WithFields 添加字段到日志
+func (s *SlogLogger) WithFields(fields ...logger.Field) logger.Logger {
+	return &SlogLogger{
+		core:   s.core,
+		level:  s.level,
+		fields: logger.AppendFields(s.fields, fields),
+		ctx:    s.ctx,
+		name:   s.name,
+		stack:  s.stack,
+	}
+}
+
+// WithField 添加单个字段到日志
+func (s *SlogLogger) WithField(key string, value interface{}) logger.Logger {
+	return s.WithFields(logger.Field{Key: key, Value: value})
+}
+
+// WithContext 添加上下文到日志
+func (s *SlogLogger) WithContext(ctx context.Context) logger.Logger {
+	return &SlogLogger{
+		core:   s.core,
+		level:  s.level,
+		fields: s.fields,
+		ctx:    ctx,
+		name:   s.name,
+		stack:  s.stack,
+	}
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (s *SlogLogger) Named(name string) logger.Logger {
+	fullName := logger.JoinLoggerName(s.name, name)
+	return &SlogLogger{
+		core:   s.core,
+		level:  logger.NewNamedLevel(fullName, s.level),
+		fields: s.fields,
+		ctx:    s.ctx,
+		name:   fullName,
+		stack:  s.stack,
+	}
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (s *SlogLogger) WithStack() logger.Logger {
+	return &SlogLogger{
+		core:   s.core,
+		level:  s.level,
+		fields: s.fields,
+		ctx:    s.ctx,
+		name:   s.name,
+		stack:  true,
+	}
+}
+
+// WithError 添加错误信息到日志
+func (s *SlogLogger) WithError(err error) logger.Logger {
+	return s.WithFields(logger.Err(err))
+}
+
+// WithTime 添加时间到日志
+func (s *SlogLogger) WithTime(t time.Time) logger.Logger {
+	return s.WithFields(logger.Time("time", t))
+}
+
+// SetLevel 设置日志级别
+func (s *SlogLogger) SetLevel(level logger.LogLevel) {
+	s.level.SetLevel(level)
+}
+
+// GetLevel 获取日志级别
+func (s *SlogLogger) GetLevel() logger.LogLevel {
+	return s.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (s *SlogLogger) AtomicLevel() *logger.AtomicLevel {
+	return s.level
+}
+
+// IsTraceEnabled 检查跟踪级别是否启用
+func (s *SlogLogger) IsTraceEnabled() bool {
+	return s.level.Enabled(logger.TraceLevel)
+}
+
+// IsDebugEnabled 检查调试级别是否启用
+func (s *SlogLogger) IsDebugEnabled() bool {
+	return s.level.Enabled(logger.DebugLevel)
+}
+
+// IsInfoEnabled 检查信息级别是否启用
+func (s *SlogLogger) IsInfoEnabled() bool {
+	return s.level.Enabled(logger.InfoLevel)
+}
+
+// IsWarnEnabled 检查警告级别是否启用
+func (s *SlogLogger) IsWarnEnabled() bool {
+	return s.level.Enabled(logger.WarnLevel)
+}
+
+// IsErrorEnabled 检查错误级别是否启用
+func (s *SlogLogger) IsErrorEnabled() bool {
+	return s.level.Enabled(logger.ErrorLevel)
+}
+
+// IsFatalEnabled 检查致命级别是否启用
+func (s *SlogLogger) IsFatalEnabled() bool {
+	return s.level.Enabled(logger.FatalLevel)
+}
+
+// IsPanicEnabled 检查恐慌级别是否启用
+func (s *SlogLogger) IsPanicEnabled() bool {
+	return s.level.Enabled(logger.PanicLevel)
+}
+
+// IsLevelEnabled 检查指定级别是否启用
+func (s *SlogLogger) IsLevelEnabled(level logger.LogLevel) bool {
+	return s.level.Enabled(level)
+}
+
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
+func (s *SlogLogger) Sync() error {
+	return logger.SyncOutputs(s.core.currentOutputs())
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (s *SlogLogger) Close() error {
+	return s.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (s *SlogLogger) Closed() bool {
+	s.core.mu.RLock()
+	defer s.core.mu.RUnlock()
+	return s.core.closed
+}
+
+// convertFields 将字段转换为slog的属性，错误字段按logger.ErrorFields展开
+func convertFields(fields []logger.Field) []slog.Attr {
+	fields = logger.ExpandErrors(fields)
+	attrs := make([]slog.Attr, len(fields))
+	for i, field := range fields {
+		attrs[i] = toSlogAttr(field)
+	}
+	return attrs
+}
+
+// toSlogAttr 将字段转换为slog的类型化属性，对象字段转换为分组，没有类型的字段使用slog.Any
+func toSlogAttr(field logger.Field) slog.Attr {
+	switch field.Type {
+	case logger.StringType:
+		return slog.String(field.Key, field.Str)
+	case logger.Int64Type:
+		return slog.Int64(field.Key, field.Integer)
+	case logger.Float64Type:
+		return slog.Float64(field.Key, math.Float64frombits(uint64(field.Integer)))
+	case logger.BoolType:
+		return slog.Bool(field.Key, field.Integer == 1)
+	case logger.DurationType:
+		return slog.Duration(field.Key, time.Duration(field.Integer))
+	case logger.TimeType, logger.TimeFullType:
+		return slog.Time(field.Key, field.Any().(time.Time))
+	case logger.ObjectType:
+		enc := &attrEncoder{}
+		if err := field.Value.(logger.ObjectMarshaler).MarshalLogObject(enc); err != nil {
+			enc.attrs = append(enc.attrs, slog.String("error", err.Error()))
+		}
+		return slog.Attr{Key: field.Key, Value: slog.GroupValue(enc.attrs...)}
+	default:
+		return slog.Any(field.Key, field.Any())
+	}
+}
+
+// attrEncoder 将对象字段编码为slog属性的ObjectEncoder
+type attrEncoder struct {
+	attrs []slog.Attr
+}
+
+// AddString 实现logger.ObjectEncoder
+func (e *attrEncoder) AddString(key, value string) {
+	e.attrs = append(e.attrs, slog.String(key, value))
+}
+
+// AddInt64 实现logger.ObjectEncoder
+func (e *attrEncoder) AddInt64(key string, value int64) {
+	e.attrs = append(e.attrs, slog.Int64(key, value))
+}
+
+// AddFloat64 实现logger.ObjectEncoder
+func (e *attrEncoder) AddFloat64(key string, value float64) {
+	e.attrs = append(e.attrs, slog.Float64(key, value))
+}
+
+// AddBool 实现logger.ObjectEncoder
+func (e *attrEncoder) AddBool(key string, value bool) {
+	e.attrs = append(e.attrs, slog.Bool(key, value))
+}
+
+// AddDuration 实现logger.ObjectEncoder
+func (e *attrEncoder) AddDuration(key string, value time.Duration) {
+	e.attrs = append(e.attrs, slog.Duration(key, value))
+}
+
+// AddTime 实现logger.ObjectEncoder
+func (e *attrEncoder) AddTime(key string, value time.Time) {
+	e.attrs = append(e.attrs, slog.Time(key, value))
+}
+
+// AddReflected 实现logger.ObjectEncoder
+func (e *attrEncoder) AddReflected(key string, value interface{}) error {
+	e.attrs = append(e.attrs, slog.Any(key, value))
+	return nil
+}
+
+// SlogLoggerProvider slog日志提供者
+type SlogLoggerProvider struct{}
+
+// NewSlogLoggerProvider 创建slog日志提供者
+func NewSlogLoggerProvider() *SlogLoggerProvider {
+	return &SlogLoggerProvider{}
+}
+
+// Create 创建日志实例
+func (p *SlogLoggerProvider) Create(name string, opts ...logger.Option) logger.Logger {
+	return NewSlogLogger(name, opts...)
+}
+
+// CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
+func (p *SlogLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) logger.Logger {
+	opts, err := logger.DecodeConfigMap(config)
+	logger.ReportError(err)
+	return NewSlogLogger(name, opts...)
+}
+
+// RegisterProvider 注册slog日志提供者
+func RegisterProvider() {
+	logger.GetLogFactory().RegisterProvider("slog", NewSlogLoggerProvider())
+}
+
+// init 自动注册slog日志提供者
+func init() {
+	RegisterProvider()
+}
@@ -3,113 +3,359 @@ package zap
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
 	"go.uber.org/zap/zapcore"
-	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/LandcLi/landc-logface/internal/logger"
 )
 
 // ZapLogger zap日志库适配器
 type ZapLogger struct {
+	core   *zapCore                 // 通过With系列方法和Named派生的实例共享同一个core
+	level  *logger.AtomicLevel      // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	cache  atomic.Pointer[zapCache] // 带有名称、级别和fields的zap实例缓存
+	fields []logger.Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// zapCore zap日志可在运行时重新配置的状态
+type zapCore struct {
+	mu             sync.RWMutex
 	logger         *zap.Logger
-	level          logger.LogLevel
-	fields         []logger.Field
-	ctx            context.Context
-	name           string
-	maxMessageSize int // 单条日志最大大小（KB）
+	outputs        []logger.Output
+	closed         bool            // 已调用Close且之后没有重新配置
+	maxMessageSize int             // 单条日志最大大小（KB）
+	caller         bool            // 是否输出调用位置
+	callerSkip     int             // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool            // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     logger.LogLevel // 附加堆栈的最低级别
+	generation     uint64          // 每次重新配置后递增，用于使派生实例的缓存失效
+}
+
+// zapCache 派生实例缓存的zap实例及其对应的配置版本
+type zapCache struct {
+	generation uint64
+	logger     *zap.Logger
 }
 
 // NewZapLogger 创建zap日志实例
 func NewZapLogger(name string, opts ...logger.Option) *ZapLogger {
-	options := &logger.LoggerOptions{
-		Level:          logger.InfoLevel,
-		Format:         "json",
-		OutputPath:     "stdout",
-		MaxLogSize:     100,                // 默认100MB
-		MaxLogAge:      7 * 24 * time.Hour, // 默认7天
-		MaxLogFiles:    10,                 // 默认10个文件
-		CompressLogs:   false,              // 默认不压缩
-		MaxMessageSize: 0,                  // 默认不限制
-		Config:         make(map[string]interface{}),
-	}
+	options := newZapOptions(opts...)
+	core := &zapCore{}
+	core.apply(options)
 
-	for _, opt := range opts {
-		opt(options)
+	return &ZapLogger{
+		core:  core,
+		level: logger.NewLoggerLevel(name, options),
+		name:  name,
 	}
+}
 
-	// 配置zap
-	zapLevel := zapcore.InfoLevel
-	switch options.Level {
-	case logger.DebugLevel:
-		zapLevel = zapcore.DebugLevel
-	case logger.InfoLevel:
-		zapLevel = zapcore.InfoLevel
-	case logger.WarnLevel:
-		zapLevel = zapcore.WarnLevel
-	case logger.ErrorLevel:
-		zapLevel = zapcore.ErrorLevel
-	case logger.FatalLevel, logger.PanicLevel:
-		zapLevel = zapcore.FatalLevel
+// newZapOptions 创建zap的配置选项，默认使用json格式
+func newZapOptions(opts ...logger.Option) *logger.LoggerOptions {
+	return logger.NewLoggerOptions(append([]logger.Option{logger.WithFormat("json")}, opts...)...)
+}
+
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *zapCore) apply(options *logger.LoggerOptions) []logger.Output {
+	// 每个输出使用自己的编码器和最低级别，日志实例的级别由leveledCore判断
+	c.mu.RLock()
+	current := c.outputs
+	c.mu.RUnlock()
+	outputs := logger.ReplaceOutputs(current, options)
+	cores := make([]zapcore.Core, len(outputs))
+	for i, output := range outputs {
+		output := output
+		enabler := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
+			return output.Enabled(fromZapLevel(level))
+		})
+		core := outputCore{
+			Core:   zapcore.NewCore(newZapEncoder(output.Format), outputSyncer{output, output.Writer}, enabler),
+			output: output,
+		}
+		if below := output.BelowLevelWriter(); below != nil {
+			core.below = zapcore.NewCore(newZapEncoder(output.Format), outputSyncer{output, below}, enabler)
+		}
+		cores[i] = core
 	}
 
-	// 配置编码器
+	// 创建logger，调用位置由output按门面的调用层级填写，不使用zap.AddCaller
+	zapLogger := zap.New(zapcore.NewTee(cores...), zap.WithFatalHook(fatalHook{}))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := c.outputs
+	c.logger = zapLogger
+	c.outputs = outputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	c.generation++
+	return old
+}
+
+// newZapEncoder 创建指定格式的编码器
+func newZapEncoder(format string) zapcore.Encoder {
 	encoderConfig := zapcore.EncoderConfig{
 		TimeKey:        "time",
 		LevelKey:       "level",
 		NameKey:        "logger",
 		CallerKey:      "caller",
+		FunctionKey:    "func",
 		MessageKey:     "msg",
 		StacktraceKey:  "stacktrace",
 		LineEnding:     zapcore.DefaultLineEnding,
-		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeLevel:    encodeLevel,
 		EncodeTime:     zapcore.ISO8601TimeEncoder,
 		EncodeDuration: zapcore.SecondsDurationEncoder,
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 	}
+	if format == "json" {
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
+	return textEncoder{zapcore.NewConsoleEncoder(encoderConfig)}
+}
 
-	var encoder zapcore.Encoder
-	if options.Format == "json" {
-		encoder = zapcore.NewJSONEncoder(encoderConfig)
-	} else {
-		encoder = zapcore.NewConsoleEncoder(encoderConfig)
-	}
-
-	// 配置输出
-	var writer zapcore.WriteSyncer
-	if options.OutputPath == "stdout" || options.OutputPath == "" {
-		writer = zapcore.AddSync(os.Stdout)
-	} else {
-		writer = zapcore.AddSync(&lumberjack.Logger{
-			Filename:   options.OutputPath,
-			MaxSize:    int(options.MaxLogSize),
-			MaxBackups: options.MaxLogFiles,
-			MaxAge:     int(options.MaxLogAge / (24 * time.Hour)),
-			Compress:   options.CompressLogs,
-		})
+// textEncoder zap的文本编码器，zap直接在日志下一行输出堆栈，这里加上缩进
+type textEncoder struct {
+	zapcore.Encoder
+}
+
+// Clone 实现zapcore.Encoder
+func (e textEncoder) Clone() zapcore.Encoder {
+	return textEncoder{e.Encoder.Clone()}
+}
+
+// EncodeEntry 实现zapcore.Encoder
+func (e textEncoder) EncodeEntry(entry zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	if entry.Stack != "" {
+		entry.Stack = logger.IndentStack(entry.Stack, "\t")
 	}
+	return e.Encoder.EncodeEntry(entry, fields)
+}
 
-	// 创建core
-	core := zapcore.NewCore(encoder, writer, zapLevel)
+// outputSyncer 将输出包装为zap的WriteSyncer，按logger.SyncOutputs刷新，不会对标准输出和标准错误调用Sync
+type outputSyncer struct {
+	output logger.Output
+	writer io.Writer // output.Writer或output.BelowLevelWriter
+}
 
-	// 创建logger
-	zapLogger := zap.New(core, zap.AddCaller())
+// Write 实现zapcore.WriteSyncer
+func (w outputSyncer) Write(p []byte) (int, error) {
+	return w.writer.Write(p)
+}
 
-	return &ZapLogger{
-		logger:         zapLogger,
-		level:          options.Level,
-		name:           name,
-		maxMessageSize: options.MaxMessageSize,
+// Sync 实现zapcore.WriteSyncer
+func (w outputSyncer) Sync() error {
+	return logger.SyncOutputs([]logger.Output{w.output})
+}
+
+// fatalHook 写入致命级日志后什么也不做，由output释放锁后刷新并退出
+// zap会把zapcore.WriteThenNoop换成直接退出的WriteThenFatal，因此使用自定义的空实现
+type fatalHook struct{}
+
+// OnWrite 实现zapcore.CheckWriteHook
+func (fatalHook) OnWrite(*zapcore.CheckedEntry, []zapcore.Field) {}
+
+// outputCore 写入一个输出的zap core
+// 输出为OverflowDropBelowLevel的异步输出时，低于OverflowLevel的日志写入below，队列已满时丢弃
+type outputCore struct {
+	zapcore.Core
+	below  zapcore.Core // 写入output.BelowLevelWriter，为nil表示不需要
+	output logger.Output
+}
+
+// With 实现zapcore.Core
+func (c outputCore) With(fields []zapcore.Field) zapcore.Core {
+	with := outputCore{Core: c.Core.With(fields), output: c.output}
+	if c.below != nil {
+		with.below = c.below.With(fields)
+	}
+	return with
+}
+
+// Check 实现zapcore.Core
+func (c outputCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.below != nil && c.output.BelowLevel(fromZapLevel(entry.Level)) {
+		return c.below.Check(entry, ce)
+	}
+	return c.Core.Check(entry, ce)
+}
+
+// leveledCore 让zap的core使用日志实例的AtomicLevel判断级别，修改级别无需重建core
+type leveledCore struct {
+	zapcore.Core
+	level *logger.AtomicLevel
+}
+
+// Enabled 实现zapcore.LevelEnabler
+func (c *leveledCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(fromZapLevel(level))
+}
+
+// With 实现zapcore.Core
+func (c *leveledCore) With(fields []zapcore.Field) zapcore.Core {
+	return &leveledCore{Core: c.Core.With(fields), level: c.level}
+}
+
+// Check 实现zapcore.Core
+func (c *leveledCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return ce
 	}
+	return c.Core.Check(entry, ce)
+}
+
+// traceZapLevel zap没有跟踪级别，使用比DebugLevel低一级的数值表示
+const traceZapLevel = zapcore.DebugLevel - 1
+
+// customZapLevels 自定义级别与zap级别的映射
+// zap没有对应的原生级别，为每个自定义级别分配一个zap未使用的负数级别，输出时由encodeLevel还原名称。
+// 不使用大于FatalLevel的数值，因为zap会为这些级别附加堆栈
+var customZapLevels = struct {
+	sync.RWMutex
+	toZap   map[logger.LogLevel]zapcore.Level
+	fromZap map[zapcore.Level]logger.LogLevel
+}{
+	toZap:   make(map[logger.LogLevel]zapcore.Level),
+	fromZap: make(map[zapcore.Level]logger.LogLevel),
+}
+
+// customZapLevel 返回自定义级别对应的zap级别，首次使用时分配，分配完时使用最近的内置级别
+func customZapLevel(level logger.LogLevel) zapcore.Level {
+	customZapLevels.RLock()
+	zapLevel, ok := customZapLevels.toZap[level]
+	customZapLevels.RUnlock()
+	if ok {
+		return zapLevel
+	}
+
+	customZapLevels.Lock()
+	defer customZapLevels.Unlock()
+	if zapLevel, ok := customZapLevels.toZap[level]; ok {
+		return zapLevel
+	}
+	next := int(traceZapLevel) - 1 - len(customZapLevels.toZap)
+	if next < math.MinInt8 {
+		return toZapLevel(logger.BaseLevel(level))
+	}
+	zapLevel = zapcore.Level(next)
+	customZapLevels.toZap[level] = zapLevel
+	customZapLevels.fromZap[zapLevel] = level
+	return zapLevel
+}
+
+// encodeLevel 以小写形式输出级别名称，包括跟踪级别和自定义级别
+func encodeLevel(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+	enc.AppendString(strings.ToLower(fromZapLevel(level).String()))
+}
+
+// toZapLevel 将日志级别转换为zap的级别
+func toZapLevel(level logger.LogLevel) zapcore.Level {
+	switch level {
+	case logger.TraceLevel:
+		return traceZapLevel
+	case logger.DebugLevel:
+		return zapcore.DebugLevel
+	case logger.WarnLevel:
+		return zapcore.WarnLevel
+	case logger.ErrorLevel:
+		return zapcore.ErrorLevel
+	case logger.FatalLevel:
+		return zapcore.FatalLevel
+	case logger.PanicLevel:
+		return zapcore.PanicLevel
+	case logger.InfoLevel:
+		return zapcore.InfoLevel
+	}
+	if logger.IsCustomLevel(level) {
+		return customZapLevel(level)
+	}
+	return toZapLevel(logger.BaseLevel(level))
+}
+
+// fromZapLevel 将zap的级别转换为日志级别
+func fromZapLevel(level zapcore.Level) logger.LogLevel {
+	switch level {
+	case traceZapLevel:
+		return logger.TraceLevel
+	case zapcore.DebugLevel:
+		return logger.DebugLevel
+	case zapcore.WarnLevel:
+		return logger.WarnLevel
+	case zapcore.ErrorLevel:
+		return logger.ErrorLevel
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return logger.PanicLevel
+	case zapcore.FatalLevel:
+		return logger.FatalLevel
+	case zapcore.InfoLevel:
+		return logger.InfoLevel
+	}
+	customZapLevels.RLock()
+	defer customZapLevels.RUnlock()
+	if custom, ok := customZapLevels.fromZap[level]; ok {
+		return custom
+	}
+	return logger.InfoLevel
+}
+
+// close 标记为已关闭并关闭所有输出
+func (c *zapCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := c.outputs
+	c.mu.Unlock()
+	return logger.CloseOutputs(outputs)
+}
+
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (z *ZapLogger) Reconfigure(opts ...logger.Option) error {
+	options := newZapOptions(opts...)
+	if options.AtomicLevel == nil {
+		z.level.SetLevel(options.Level)
+	}
+	old := z.core.apply(options)
+	return logger.CloseOutputs(old)
+}
+
+// zapLogger 获取带有当前实例名称、级别和fields的zap实例，调用方需持有core的读锁
+func (z *ZapLogger) zapLogger() *zap.Logger {
+	if cached := z.cache.Load(); cached != nil && cached.generation == z.core.generation {
+		return cached.logger
+	}
+
+	zapLogger := z.core.logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &leveledCore{Core: core, level: z.level}
+	}))
+	if z.name != "" {
+		zapLogger = zapLogger.Named(z.name)
+	}
+	if len(z.fields) > 0 {
+		zapLogger = zapLogger.With(z.convertFields(z.fields)...)
+	}
+	z.cache.Store(&zapCache{generation: z.core.generation, logger: zapLogger})
+	return zapLogger
 }
 
 // limitMessageSize 限制日志消息大小
 func (z *ZapLogger) limitMessageSize(msg string) string {
-	if z.maxMessageSize > 0 {
-		maxSize := z.maxMessageSize * 1024 // 转换为字节
+	if z.core.maxMessageSize > 0 {
+		maxSize := z.core.maxMessageSize * 1024 // 转换为字节
 		if len(msg) > maxSize {
 			return msg[:maxSize-3] + "..."
 		}
@@ -117,93 +363,238 @@ func (z *ZapLogger) limitMessageSize(msg string) string {
 	return msg
 }
 
+// output 输出日志
+func (z *ZapLogger) output(ctx context.Context, level logger.LogLevel, msg string, fields []logger.Field) {
+	if !z.level.Enabled(level) {
+		return
+	}
+	z.write(level, msg, logger.WithContextFields(ctx, fields))
+
+	// 退出时会刷新所有日志实例，需要在释放锁之后进行，否则会与等待中的重新配置互相等待
+	if toZapLevel(level) == zapcore.FatalLevel {
+		if err := z.Sync(); err != nil {
+			logger.ReportError(err)
+		}
+		logger.Exit(1)
+	}
+}
+
+// write 持有读锁写入一条日志，恐慌级日志由zap在写入后触发panic
+func (z *ZapLogger) write(level logger.LogLevel, msg string, fields []logger.Field) {
+	z.core.mu.RLock()
+	defer z.core.mu.RUnlock()
+
+	zapLogger := z.zapLogger()
+	if ce := zapLogger.Check(toZapLevel(level), z.limitMessageSize(msg)); ce != nil {
+		if z.core.caller {
+			if frame, ok := logger.CallerFrame(z.core.callerSkip); ok {
+				ce.Caller = zapcore.EntryCaller{
+					Defined:  true,
+					PC:       frame.PC,
+					File:     frame.File,
+					Line:     frame.Line,
+					Function: frame.Function,
+				}
+			}
+		}
+		if z.stack || (z.core.stackTrace && level.Severity() >= z.core.stackLevel.Severity()) {
+			ce.Stack = logger.Stack(z.core.callerSkip)
+		}
+		ce.Write(z.convertFields(fields)...)
+	}
+}
+
+// Trace 输出跟踪级日志
+func (z *ZapLogger) Trace(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (z *ZapLogger) Tracef(format string, args ...interface{}) {
+	if z.IsTraceEnabled() {
+		z.output(z.ctx, logger.TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if z.IsTraceEnabled() {
+		z.Trace(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
 // Debug 输出调试级日志
 func (z *ZapLogger) Debug(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Debug(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.DebugLevel, msg, fields)
 }
 
 // Debugf 输出格式化的调试级日志
 func (z *ZapLogger) Debugf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Debug(msg)
+	if z.IsDebugEnabled() {
+		z.output(z.ctx, logger.DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if z.IsDebugEnabled() {
+		z.Debug(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Info 输出信息级日志
 func (z *ZapLogger) Info(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Info(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.InfoLevel, msg, fields)
 }
 
 // Infof 输出格式化的信息级日志
 func (z *ZapLogger) Infof(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Info(msg)
+	if z.IsInfoEnabled() {
+		z.output(z.ctx, logger.InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if z.IsInfoEnabled() {
+		z.Info(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Warn 输出警告级日志
 func (z *ZapLogger) Warn(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Warn(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.WarnLevel, msg, fields)
 }
 
 // Warnf 输出格式化的警告级日志
 func (z *ZapLogger) Warnf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Warn(msg)
+	if z.IsWarnEnabled() {
+		z.output(z.ctx, logger.WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if z.IsWarnEnabled() {
+		z.Warn(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Error 输出错误级日志
 func (z *ZapLogger) Error(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Error(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.ErrorLevel, msg, fields)
 }
 
 // Errorf 输出格式化的错误级日志
 func (z *ZapLogger) Errorf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Error(msg)
+	if z.IsErrorEnabled() {
+		z.output(z.ctx, logger.ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if z.IsErrorEnabled() {
+		z.Error(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Fatal 输出致命级日志并退出程序
 func (z *ZapLogger) Fatal(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Fatal(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.FatalLevel, msg, fields)
 }
 
 // Fatalf 输出格式化的致命级日志并退出程序
 func (z *ZapLogger) Fatalf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Fatal(msg)
+	if z.IsFatalEnabled() {
+		z.output(z.ctx, logger.FatalLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if z.IsFatalEnabled() {
+		z.Fatal(msg, logger.KeyValueFields(keysAndValues)...)
+	}
 }
 
 // Panic 输出恐慌级日志并触发panic
 func (z *ZapLogger) Panic(msg string, fields ...logger.Field) {
-	msg = z.limitMessageSize(msg)
-	z.logger.Panic(msg, z.convertFields(fields)...)
+	z.output(z.ctx, logger.PanicLevel, msg, fields)
 }
 
 // Panicf 输出格式化的恐慌级日志并触发panic
 func (z *ZapLogger) Panicf(format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	msg = z.limitMessageSize(msg)
-	z.logger.Panic(msg)
+	if z.IsPanicEnabled() {
+		z.output(z.ctx, logger.PanicLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if z.IsPanicEnabled() {
+		z.Panic(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (z *ZapLogger) Log(level logger.LogLevel, msg string, fields ...logger.Field) {
+	z.output(z.ctx, level, msg, fields)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (z *ZapLogger) Logf(level logger.LogLevel, format string, args ...interface{}) {
+	if z.IsLevelEnabled(level) {
+		z.output(z.ctx, level, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (z *ZapLogger) Logw(level logger.LogLevel, msg string, keysAndValues ...interface{}) {
+	if z.IsLevelEnabled(level) {
+		z.Log(level, msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (z *ZapLogger) TraceContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (z *ZapLogger) DebugContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (z *ZapLogger) InfoContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (z *ZapLogger) WarnContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (z *ZapLogger) ErrorContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (z *ZapLogger) LogContext(ctx context.Context, level logger.LogLevel, msg string, fields ...logger.Field) {
+	z.output(ctx, level, msg, fields)
 }
 
 // WithFields 添加字段到日志
 func (z *ZapLogger) WithFields(fields ...logger.Field) logger.Logger {
 	return &ZapLogger{
-		logger:         z.logger.With(z.convertFields(fields)...),
-		level:          z.level,
-		fields:         append(z.fields, fields...),
-		ctx:            z.ctx,
-		name:           z.name,
-		maxMessageSize: z.maxMessageSize,
+		core:   z.core,
+		level:  z.level,
+		fields: logger.AppendFields(z.fields, fields),
+		ctx:    z.ctx,
+		name:   z.name,
+		stack:  z.stack,
 	}
 }
 
@@ -215,79 +606,187 @@ func (z *ZapLogger) WithField(key string, value interface{}) logger.Logger {
 // WithContext 添加上下文到日志
 func (z *ZapLogger) WithContext(ctx context.Context) logger.Logger {
 	return &ZapLogger{
-		logger:         z.logger,
-		level:          z.level,
-		fields:         z.fields,
-		ctx:            ctx,
-		name:           z.name,
-		maxMessageSize: z.maxMessageSize,
+		core:   z.core,
+		level:  z.level,
+		fields: z.fields,
+		ctx:    ctx,
+		name:   z.name,
+		stack:  z.stack,
+	}
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (z *ZapLogger) Named(name string) logger.Logger {
+	fullName := logger.JoinLoggerName(z.name, name)
+	return &ZapLogger{
+		core:   z.core,
+		level:  logger.NewNamedLevel(fullName, z.level),
+		fields: z.fields,
+		ctx:    z.ctx,
+		name:   fullName,
+		stack:  z.stack,
 	}
 }
 
 // WithError 添加错误信息到日志
 func (z *ZapLogger) WithError(err error) logger.Logger {
-	return z.WithField("error", err)
+	return z.WithFields(logger.Err(err))
 }
 
 // WithTime 添加时间到日志
 func (z *ZapLogger) WithTime(t time.Time) logger.Logger {
-	return z.WithField("time", t)
+	return z.WithFields(logger.Time("time", t))
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (z *ZapLogger) WithStack() logger.Logger {
+	return &ZapLogger{
+		core:   z.core,
+		level:  z.level,
+		fields: z.fields,
+		ctx:    z.ctx,
+		name:   z.name,
+		stack:  true,
+	}
 }
 
 // SetLevel 设置日志级别
 func (z *ZapLogger) SetLevel(level logger.LogLevel) {
-	z.level = level
+	z.level.SetLevel(level)
 }
 
 // GetLevel 获取日志级别
 func (z *ZapLogger) GetLevel() logger.LogLevel {
+	return z.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (z *ZapLogger) AtomicLevel() *logger.AtomicLevel {
 	return z.level
 }
 
+// IsTraceEnabled 检查跟踪级别是否启用
+func (z *ZapLogger) IsTraceEnabled() bool {
+	return z.level.Enabled(logger.TraceLevel)
+}
+
 // IsDebugEnabled 检查调试级别是否启用
 func (z *ZapLogger) IsDebugEnabled() bool {
-	return z.level <= logger.DebugLevel
+	return z.level.Enabled(logger.DebugLevel)
 }
 
 // IsInfoEnabled 检查信息级别是否启用
 func (z *ZapLogger) IsInfoEnabled() bool {
-	return z.level <= logger.InfoLevel
+	return z.level.Enabled(logger.InfoLevel)
 }
 
 // IsWarnEnabled 检查警告级别是否启用
 func (z *ZapLogger) IsWarnEnabled() bool {
-	return z.level <= logger.WarnLevel
+	return z.level.Enabled(logger.WarnLevel)
 }
 
 // IsErrorEnabled 检查错误级别是否启用
 func (z *ZapLogger) IsErrorEnabled() bool {
-	return z.level <= logger.ErrorLevel
+	return z.level.Enabled(logger.ErrorLevel)
 }
 
 // IsFatalEnabled 检查致命级别是否启用
 func (z *ZapLogger) IsFatalEnabled() bool {
-	return z.level <= logger.FatalLevel
+	return z.level.Enabled(logger.FatalLevel)
 }
 
 // IsPanicEnabled 检查恐慌级别是否启用
 func (z *ZapLogger) IsPanicEnabled() bool {
-	return z.level <= logger.PanicLevel
+	return z.level.Enabled(logger.PanicLevel)
 }
 
-// Sync 刷新日志缓冲区
+// IsLevelEnabled 检查指定级别是否启用
+func (z *ZapLogger) IsLevelEnabled(level logger.LogLevel) bool {
+	return z.level.Enabled(level)
+}
+
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
 func (z *ZapLogger) Sync() error {
-	return z.logger.Sync()
+	z.core.mu.RLock()
+	defer z.core.mu.RUnlock()
+	return z.core.logger.Sync()
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (z *ZapLogger) Close() error {
+	return z.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (z *ZapLogger) Closed() bool {
+	z.core.mu.RLock()
+	defer z.core.mu.RUnlock()
+	return z.core.closed
 }
 
-// convertFields 转换字段
+// convertFields 转换字段，错误字段按logger.ErrorFields展开，与其他提供者的输出保持一致
 func (z *ZapLogger) convertFields(fields []logger.Field) []zap.Field {
+	fields = logger.ExpandErrors(fields)
 	zapFields := make([]zap.Field, len(fields))
 	for i, field := range fields {
-		zapFields[i] = zap.Any(field.Key, field.Value)
+		zapFields[i] = toZapField(field)
 	}
 	return zapFields
 }
 
+// toZapField 将字段转换为zap的类型化字段，没有类型的字段使用zap.Any
+func toZapField(field logger.Field) zap.Field {
+	switch field.Type {
+	case logger.StringType:
+		return zap.String(field.Key, field.Str)
+	case logger.Int64Type:
+		return zap.Int64(field.Key, field.Integer)
+	case logger.Float64Type:
+		return zap.Float64(field.Key, math.Float64frombits(uint64(field.Integer)))
+	case logger.BoolType:
+		return zap.Bool(field.Key, field.Integer == 1)
+	case logger.DurationType:
+		return zap.Duration(field.Key, time.Duration(field.Integer))
+	case logger.TimeType:
+		// 与zap.Time的内部表示相同，无需还原为time.Time
+		return zap.Field{Key: field.Key, Type: zapcore.TimeType, Integer: field.Integer, Interface: field.Value}
+	case logger.TimeFullType:
+		return zap.Time(field.Key, field.Value.(time.Time))
+	case logger.ErrorType:
+		return zap.NamedError(field.Key, field.Value.(error))
+	case logger.StringerType:
+		return zap.Stringer(field.Key, field.Value.(fmt.Stringer))
+	case logger.ObjectType:
+		return zap.Object(field.Key, zapObject{field.Value.(logger.ObjectMarshaler)})
+	case logger.ArrayType:
+		return zap.Array(field.Key, zapArray{field.Value.(logger.ArrayMarshaler)})
+	default:
+		return zap.Any(field.Key, field.Value)
+	}
+}
+
+// zapObject 将ObjectMarshaler适配为zapcore.ObjectMarshaler
+type zapObject struct {
+	logger.ObjectMarshaler
+}
+
+// MarshalLogObject 实现zapcore.ObjectMarshaler
+func (o zapObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return o.ObjectMarshaler.MarshalLogObject(enc)
+}
+
+// zapArray 将ArrayMarshaler适配为zapcore.ArrayMarshaler
+type zapArray struct {
+	logger.ArrayMarshaler
+}
+
+// MarshalLogArray 实现zapcore.ArrayMarshaler
+func (a zapArray) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return a.ArrayMarshaler.MarshalLogArray(enc)
+}
+
 // ZapLoggerProvider zap日志提供者
 type ZapLoggerProvider struct{}
 
@@ -302,34 +801,10 @@ func (p *ZapLoggerProvider) Create(name string, opts ...logger.Option) logger.Lo
 }
 
 // CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
 func (p *ZapLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) logger.Logger {
-	opts := make([]logger.Option, 0)
-
-	if level, ok := config["level"].(logger.LogLevel); ok {
-		opts = append(opts, logger.WithLevel(level))
-	}
-	if format, ok := config["format"].(string); ok {
-		opts = append(opts, logger.WithFormat(format))
-	}
-	if outputPath, ok := config["outputPath"].(string); ok {
-		opts = append(opts, logger.WithOutputPath(outputPath))
-	}
-	if maxLogSize, ok := config["maxLogSize"].(int64); ok {
-		opts = append(opts, logger.WithMaxLogSize(maxLogSize))
-	}
-	if maxLogAge, ok := config["maxLogAge"].(time.Duration); ok {
-		opts = append(opts, logger.WithMaxLogAge(maxLogAge))
-	}
-	if maxLogFiles, ok := config["maxLogFiles"].(int); ok {
-		opts = append(opts, logger.WithMaxLogFiles(maxLogFiles))
-	}
-	if compressLogs, ok := config["compressLogs"].(bool); ok {
-		opts = append(opts, logger.WithCompressLogs(compressLogs))
-	}
-	if maxMessageSize, ok := config["maxMessageSize"].(int); ok {
-		opts = append(opts, logger.WithMaxMessageSize(maxMessageSize))
-	}
-
+	opts, err := logger.DecodeConfigMap(config)
+	logger.ReportError(err)
 	return NewZapLogger(name, opts...)
 }
 
@@ -0,0 +1,775 @@
+package zerolog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/LandcLi/landc-logface/internal/logger"
+)
+
+// ZerologLogger zerolog日志库适配器
+type ZerologLogger struct {
+	core   *zerologCore                 // 通过With系列方法和Named派生的实例共享同一个core
+	level  *logger.AtomicLevel          // 与WithField等派生的实例共享，Named派生的实例有自己的级别
+	cache  atomic.Pointer[zerologCache] // 各输出带有名称和fields的zerolog实例缓存
+	fields []logger.Field
+	ctx    context.Context
+	name   string
+	stack  bool // 由WithStack设置，为每条日志附加堆栈
+}
+
+// zerologCore zerolog日志可在运行时重新配置的状态
+type zerologCore struct {
+	mu             sync.RWMutex
+	outputs        []zerologOutput
+	closed         bool            // 已调用Close且之后没有重新配置
+	maxMessageSize int             // 单条日志最大大小（KB）
+	caller         bool            // 是否输出调用位置
+	callerSkip     int             // 输出调用位置时额外跳过的调用层数
+	stackTrace     bool            // 是否为级别不低于stackLevel的日志附加堆栈
+	stackLevel     logger.LogLevel // 附加堆栈的最低级别
+	generation     uint64          // 每次重新配置后递增，用于使派生实例的缓存失效
+}
+
+// zerologOutput zerolog日志的一个输出
+type zerologOutput struct {
+	logger.Output
+	logger zerolog.Logger // 级别由各日志实例的AtomicLevel和输出的最低级别判断，级别字段由output写入
+	below  io.Writer      // 包装BelowLevelWriter，用于低于OverflowLevel的日志，队列已满时丢弃；为nil表示不需要
+}
+
+// zerologCache 派生实例缓存的各输出的zerolog实例及其对应的配置版本
+type zerologCache struct {
+	generation uint64
+	loggers    []zerolog.Logger
+}
+
+// NewZerologLogger 创建zerolog日志实例
+func NewZerologLogger(name string, opts ...logger.Option) *ZerologLogger {
+	options := newZerologOptions(opts...)
+	core := &zerologCore{}
+	core.apply(options)
+
+	return &ZerologLogger{
+		core:  core,
+		level: logger.NewLoggerLevel(name, options),
+		ctx:   context.Background(),
+		name:  name,
+	}
+}
+
+// newZerologOptions 创建zerolog的配置选项，与zap相同默认使用json格式
+func newZerologOptions(opts ...logger.Option) *logger.LoggerOptions {
+	return logger.NewLoggerOptions(append([]logger.Option{logger.WithFormat("json")}, opts...)...)
+}
+
+// newZerologWriter 返回zerolog写入writer时使用的输出，format为输出格式
+func newZerologWriter(writer io.Writer, format string) io.Writer {
+	if format == "json" {
+		return writer
+	}
+	// 文本格式使用zerolog的ConsoleWriter，堆栈不作为字段，而是以缩进块的形式写在日志下方
+	return zerolog.ConsoleWriter{
+		Out:           writer,
+		NoColor:       true,
+		TimeFormat:    "2006-01-02 15:04:05",
+		FieldsExclude: []string{logger.StackKey},
+		FormatLevel: func(i interface{}) string {
+			return strings.ToUpper(fmt.Sprint(i))
+		},
+		FormatCaller: func(i interface{}) string {
+			caller, _ := i.(string)
+			return caller
+		},
+		FormatExtra: formatStack,
+	}
+}
+
+// apply 应用配置选项，返回被替换下来的旧输出
+func (c *zerologCore) apply(options *logger.LoggerOptions) []logger.Output {
+	outputs := logger.ReplaceOutputs(c.currentOutputs(), options)
+	zerologOutputs := make([]zerologOutput, len(outputs))
+	for i, output := range outputs {
+		zerologOutputs[i] = zerologOutput{Output: output, logger: zerolog.New(newZerologWriter(output.Writer, output.Format)).With().Timestamp().Logger()}
+		if below := output.BelowLevelWriter(); below != nil {
+			zerologOutputs[i].below = newZerologWriter(below, output.Format)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		old[i] = output.Output
+	}
+	c.outputs = zerologOutputs
+	c.closed = false
+	c.maxMessageSize = options.MaxMessageSize
+	c.caller = options.Caller
+	c.callerSkip = options.CallerSkip
+	c.stackTrace = options.StackTrace
+	c.stackLevel = options.StackLevel
+	c.generation++
+	return old
+}
+
+// formatStack 在文本格式的日志下方以缩进块的形式输出堆栈
+func formatStack(event map[string]interface{}, buf *bytes.Buffer) error {
+	if stack, ok := event[logger.StackKey].(string); ok && stack != "" {
+		buf.WriteString("\n")
+		buf.WriteString(logger.IndentStack(stack, "\t"))
+	}
+	return nil
+}
+
+// currentOutputs 返回当前使用的所有输出
+func (c *zerologCore) currentOutputs() []logger.Output {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	return outputs
+}
+
+// close 标记为已关闭并关闭所有输出
+func (c *zerologCore) close() error {
+	c.mu.Lock()
+	c.closed = true
+	outputs := make([]logger.Output, len(c.outputs))
+	for i, output := range c.outputs {
+		outputs[i] = output.Output
+	}
+	c.mu.Unlock()
+	return logger.CloseOutputs(outputs)
+}
+
+// Reconfigure 重新配置日志级别、格式和输出
+// 格式和输出对所有派生实例生效，级别对Named派生的实例不生效
+func (z *ZerologLogger) Reconfigure(opts ...logger.Option) error {
+	options := newZerologOptions(opts...)
+	if options.AtomicLevel == nil {
+		z.level.SetLevel(options.Level)
+	}
+	old := z.core.apply(options)
+	return logger.CloseOutputs(old)
+}
+
+// zerologLoggers 获取各输出带有当前实例名称和fields的zerolog实例，调用方需持有core的读锁
+func (z *ZerologLogger) zerologLoggers() []zerolog.Logger {
+	if cached := z.cache.Load(); cached != nil && cached.generation == z.core.generation {
+		return cached.loggers
+	}
+
+	fields := logger.ExpandErrors(z.fields)
+	loggers := make([]zerolog.Logger, len(z.core.outputs))
+	for i, output := range z.core.outputs {
+		zerologContext := output.logger.With()
+		if z.name != "" {
+			zerologContext = zerologContext.Str("logger", z.name)
+		}
+		if len(fields) > 0 {
+			zerologContext = zerologContext.EmbedObject(fieldsObject(fields))
+		}
+		loggers[i] = zerologContext.Logger()
+	}
+	z.cache.Store(&zerologCache{generation: z.core.generation, loggers: loggers})
+	return loggers
+}
+
+// limitMessageSize 限制日志消息大小
+func (z *ZerologLogger) limitMessageSize(msg string) string {
+	if z.core.maxMessageSize > 0 {
+		maxSize := z.core.maxMessageSize * 1024 // 转换为字节
+		if len(msg) > maxSize {
+			return msg[:maxSize-3] + "..."
+		}
+	}
+	return msg
+}
+
+// toZerologLevel 将内置级别转换为zerolog的同名级别，跟踪级别和自定义级别返回NoLevel和false
+// WithLevel使用FatalLevel和PanicLevel时不会退出或panic，由output处理
+func toZerologLevel(level logger.LogLevel) (zerolog.Level, bool) {
+	switch level {
+	case logger.DebugLevel:
+		return zerolog.DebugLevel, true
+	case logger.InfoLevel:
+		return zerolog.InfoLevel, true
+	case logger.WarnLevel:
+		return zerolog.WarnLevel, true
+	case logger.ErrorLevel:
+		return zerolog.ErrorLevel, true
+	case logger.FatalLevel:
+		return zerolog.FatalLevel, true
+	case logger.PanicLevel:
+		return zerolog.PanicLevel, true
+	default:
+		return zerolog.NoLevel, false
+	}
+}
+
+// output 输出日志，Fatal级别输出后退出程序，Panic级别输出后触发panic
+// Debug到Panic以zerolog的同名级别输出，受zerolog全局级别的过滤，钩子和采样器也能得到原生级别；
+// 跟踪级别和自定义级别以NoLevel事件输出并由这里写入级别名称，因此不受zerolog全局级别（默认为Debug）的过滤
+func (z *ZerologLogger) output(ctx context.Context, level logger.LogLevel, msg string, fields []logger.Field) {
+	if !z.level.Enabled(level) {
+		return
+	}
+	fields = logger.WithContextFields(ctx, fields)
+
+	z.core.mu.RLock()
+	var caller runtime.Frame
+	hasCaller := false
+	if z.core.caller {
+		caller, hasCaller = logger.CallerFrame(z.core.callerSkip)
+	}
+	stack := ""
+	if z.stack || (z.core.stackTrace && level.Severity() >= z.core.stackLevel.Severity()) {
+		stack = logger.Stack(z.core.callerSkip)
+	}
+	fields = logger.ExpandErrors(fields)
+	msg = z.limitMessageSize(msg)
+	for i, zerologLogger := range z.zerologLoggers() {
+		output := z.core.outputs[i]
+		if !output.Enabled(level) {
+			continue
+		}
+		if output.below != nil && output.BelowLevel(level) {
+			zerologLogger = zerologLogger.Output(output.below)
+		}
+		zerologLevel, native := toZerologLevel(level)
+		event := zerologLogger.WithLevel(zerologLevel)
+		if event == nil {
+			continue
+		}
+		if !native {
+			event.Str(zerolog.LevelFieldName, strings.ToLower(level.String()))
+		}
+		if hasCaller {
+			event.Str(zerolog.CallerFieldName, logger.FormatCaller(caller)).Str("func", caller.Function)
+		}
+		if len(fields) > 0 {
+			event.EmbedObject(fieldsObject(fields))
+		}
+		if stack != "" {
+			event.Str(logger.StackKey, stack)
+		}
+		event.Msg(msg)
+	}
+	z.core.mu.RUnlock()
+
+	switch level {
+	case logger.FatalLevel:
+		if err := z.Sync(); err != nil {
+			logger.ReportError(err)
+		}
+		logger.Exit(1)
+	case logger.PanicLevel:
+		panic(msg)
+	}
+}
+
+// Trace 输出跟踪级日志
+func (z *ZerologLogger) Trace(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.TraceLevel, msg, fields)
+}
+
+// Tracef 输出格式化的跟踪级日志
+func (z *ZerologLogger) Tracef(format string, args ...interface{}) {
+	if z.IsTraceEnabled() {
+		z.output(z.ctx, logger.TraceLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	if z.IsTraceEnabled() {
+		z.Trace(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Debug 输出调试级日志
+func (z *ZerologLogger) Debug(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.DebugLevel, msg, fields)
+}
+
+// Debugf 输出格式化的调试级日志
+func (z *ZerologLogger) Debugf(format string, args ...interface{}) {
+	if z.IsDebugEnabled() {
+		z.output(z.ctx, logger.DebugLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if z.IsDebugEnabled() {
+		z.Debug(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Info 输出信息级日志
+func (z *ZerologLogger) Info(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.InfoLevel, msg, fields)
+}
+
+// Infof 输出格式化的信息级日志
+func (z *ZerologLogger) Infof(format string, args ...interface{}) {
+	if z.IsInfoEnabled() {
+		z.output(z.ctx, logger.InfoLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if z.IsInfoEnabled() {
+		z.Info(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Warn 输出警告级日志
+func (z *ZerologLogger) Warn(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.WarnLevel, msg, fields)
+}
+
+// Warnf 输出格式化的警告级日志
+func (z *ZerologLogger) Warnf(format string, args ...interface{}) {
+	if z.IsWarnEnabled() {
+		z.output(z.ctx, logger.WarnLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if z.IsWarnEnabled() {
+		z.Warn(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Error 输出错误级日志
+func (z *ZerologLogger) Error(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.ErrorLevel, msg, fields)
+}
+
+// Errorf 输出格式化的错误级日志
+func (z *ZerologLogger) Errorf(format string, args ...interface{}) {
+	if z.IsErrorEnabled() {
+		z.output(z.ctx, logger.ErrorLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if z.IsErrorEnabled() {
+		z.Error(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Fatal 输出致命级日志并退出程序
+func (z *ZerologLogger) Fatal(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.FatalLevel, msg, fields)
+}
+
+// Fatalf 输出格式化的致命级日志并退出程序
+func (z *ZerologLogger) Fatalf(format string, args ...interface{}) {
+	if z.IsFatalEnabled() {
+		z.output(z.ctx, logger.FatalLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	if z.IsFatalEnabled() {
+		z.Fatal(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Panic 输出恐慌级日志并触发panic
+func (z *ZerologLogger) Panic(msg string, fields ...logger.Field) {
+	z.output(z.ctx, logger.PanicLevel, msg, fields)
+}
+
+// Panicf 输出格式化的恐慌级日志并触发panic
+func (z *ZerologLogger) Panicf(format string, args ...interface{}) {
+	if z.IsPanicEnabled() {
+		z.output(z.ctx, logger.PanicLevel, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	if z.IsPanicEnabled() {
+		z.Panic(msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// Log 按指定级别输出日志，FatalLevel和PanicLevel与Fatal、Panic的行为相同
+func (z *ZerologLogger) Log(level logger.LogLevel, msg string, fields ...logger.Field) {
+	z.output(z.ctx, level, msg, fields)
+}
+
+// Logf 按指定级别输出格式化的日志
+func (z *ZerologLogger) Logf(level logger.LogLevel, format string, args ...interface{}) {
+	if z.IsLevelEnabled(level) {
+		z.output(z.ctx, level, fmt.Sprintf(format, args...), nil)
+	}
+}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (z *ZerologLogger) Logw(level logger.LogLevel, msg string, keysAndValues ...interface{}) {
+	if z.IsLevelEnabled(level) {
+		z.Log(level, msg, logger.KeyValueFields(keysAndValues)...)
+	}
+}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) TraceContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.TraceLevel, msg, fields)
+}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) DebugContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.DebugLevel, msg, fields)
+}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) InfoContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.InfoLevel, msg, fields)
+}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) WarnContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.WarnLevel, msg, fields)
+}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) ErrorContext(ctx context.Context, msg string, fields ...logger.Field) {
+	z.output(ctx, logger.ErrorLevel, msg, fields)
+}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (z *ZerologLogger) LogContext(ctx context.Context, level logger.LogLevel, msg string, fields ...logger.Field) {
+	z.output(ctx, level, msg, fields)
+}
+
+// WithFields 添加字段到日志
+func (z *ZerologLogger) WithFields(fields ...logger.Field) logger.Logger {
+	return &ZerologLogger{
+		core:   z.core,
+		level:  z.level,
+		fields: logger.AppendFields(z.fields, fields),
+		ctx:    z.ctx,
+		name:   z.name,
+		stack:  z.stack,
+	}
+}
+
+// WithField 添加单个字段到日志
+func (z *ZerologLogger) WithField(key string, value interface{}) logger.Logger {
+	return z.WithFields(logger.Field{Key: key, Value: value})
+}
+
+// WithContext 添加上下文到日志
+func (z *ZerologLogger) WithContext(ctx context.Context) logger.Logger {
+	return &ZerologLogger{
+		core:   z.core,
+		level:  z.level,
+		fields: z.fields,
+		ctx:    ctx,
+		name:   z.name,
+		stack:  z.stack,
+	}
+}
+
+// Named 创建名称为 "父名称.name" 的子日志实例
+// 子实例继承当前级别，之后可以单独修改，也会跟随级别注册表中为祖先设置的级别
+func (z *ZerologLogger) Named(name string) logger.Logger {
+	fullName := logger.JoinLoggerName(z.name, name)
+	return &ZerologLogger{
+		core:   z.core,
+		level:  logger.NewNamedLevel(fullName, z.level),
+		fields: z.fields,
+		ctx:    z.ctx,
+		name:   fullName,
+		stack:  z.stack,
+	}
+}
+
+// WithStack 为派生实例输出的每条日志附加调用堆栈
+func (z *ZerologLogger) WithStack() logger.Logger {
+	return &ZerologLogger{
+		core:   z.core,
+		level:  z.level,
+		fields: z.fields,
+		ctx:    z.ctx,
+		name:   z.name,
+		stack:  true,
+	}
+}
+
+// WithError 添加错误信息到日志
+func (z *ZerologLogger) WithError(err error) logger.Logger {
+	return z.WithFields(logger.Err(err))
+}
+
+// WithTime 添加时间到日志
+func (z *ZerologLogger) WithTime(t time.Time) logger.Logger {
+	return z.WithFields(logger.Time("time", t))
+}
+
+// SetLevel 设置日志级别
+func (z *ZerologLogger) SetLevel(level logger.LogLevel) {
+	z.level.SetLevel(level)
+}
+
+// GetLevel 获取日志级别
+func (z *ZerologLogger) GetLevel() logger.LogLevel {
+	return z.level.Level()
+}
+
+// AtomicLevel 获取与WithField等派生实例共享的日志级别
+func (z *ZerologLogger) AtomicLevel() *logger.AtomicLevel {
+	return z.level
+}
+
+// IsTraceEnabled 检查跟踪级别是否启用
+func (z *ZerologLogger) IsTraceEnabled() bool {
+	return z.level.Enabled(logger.TraceLevel)
+}
+
+// IsDebugEnabled 检查调试级别是否启用
+func (z *ZerologLogger) IsDebugEnabled() bool {
+	return z.level.Enabled(logger.DebugLevel)
+}
+
+// IsInfoEnabled 检查信息级别是否启用
+func (z *ZerologLogger) IsInfoEnabled() bool {
+	return z.level.Enabled(logger.InfoLevel)
+}
+
+// IsWarnEnabled 检查警告级别是否启用
+func (z *ZerologLogger) IsWarnEnabled() bool {
+	return z.level.Enabled(logger.WarnLevel)
+}
+
+// IsErrorEnabled 检查错误级别是否启用
+func (z *ZerologLogger) IsErrorEnabled() bool {
+	return z.level.Enabled(logger.ErrorLevel)
+}
+
+// IsFatalEnabled 检查致命级别是否启用
+func (z *ZerologLogger) IsFatalEnabled() bool {
+	return z.level.Enabled(logger.FatalLevel)
+}
+
+// IsPanicEnabled 检查恐慌级别是否启用
+func (z *ZerologLogger) IsPanicEnabled() bool {
+	return z.level.Enabled(logger.PanicLevel)
+}
+
+// IsLevelEnabled 检查指定级别是否启用
+func (z *ZerologLogger) IsLevelEnabled(level logger.LogLevel) bool {
+	return z.level.Enabled(level)
+}
+
+// Sync 刷新日志缓冲区，异步写入时等待队列中的日志全部写入
+func (z *ZerologLogger) Sync() error {
+	return logger.SyncOutputs(z.core.currentOutputs())
+}
+
+// Close 写完缓冲的日志并关闭所有输出，对所有派生实例生效
+// 关闭后写入文件的日志会被丢弃，标准输出和标准错误不受影响，重新配置后恢复写入
+func (z *ZerologLogger) Close() error {
+	return z.core.close()
+}
+
+// Closed 返回是否已调用Close且之后没有重新配置，日志工厂不再跟踪已关闭的实例
+func (z *ZerologLogger) Closed() bool {
+	z.core.mu.RLock()
+	defer z.core.mu.RUnlock()
+	return z.core.closed
+}
+
+// fieldsObject 将一组字段编码为zerolog的事件字段，错误字段需事先按logger.ErrorFields展开
+type fieldsObject []logger.Field
+
+// MarshalZerologObject 实现zerolog.LogObjectMarshaler
+func (o fieldsObject) MarshalZerologObject(e *zerolog.Event) {
+	for _, field := range o {
+		appendField(e, field)
+	}
+}
+
+// appendField 将字段转换为zerolog的类型化字段，没有类型的字段使用Interface
+func appendField(e *zerolog.Event, field logger.Field) {
+	switch field.Type {
+	case logger.StringType:
+		e.Str(field.Key, field.Str)
+	case logger.Int64Type:
+		e.Int64(field.Key, field.Integer)
+	case logger.Float64Type:
+		e.Float64(field.Key, math.Float64frombits(uint64(field.Integer)))
+	case logger.BoolType:
+		e.Bool(field.Key, field.Integer == 1)
+	case logger.DurationType:
+		e.Dur(field.Key, time.Duration(field.Integer))
+	case logger.TimeType, logger.TimeFullType:
+		e.Time(field.Key, field.Any().(time.Time))
+	case logger.ErrorType, logger.StringerType:
+		e.Str(field.Key, field.Any().(string))
+	case logger.ObjectType:
+		e.Object(field.Key, zerologObject{field.Value.(logger.ObjectMarshaler)})
+	case logger.ArrayType:
+		e.Array(field.Key, zerologArray{field.Value.(logger.ArrayMarshaler)})
+	default:
+		e.Interface(field.Key, field.Value)
+	}
+}
+
+// zerologObject 将ObjectMarshaler适配为zerolog.LogObjectMarshaler
+type zerologObject struct {
+	logger.ObjectMarshaler
+}
+
+// MarshalZerologObject 实现zerolog.LogObjectMarshaler
+func (o zerologObject) MarshalZerologObject(e *zerolog.Event) {
+	if err := o.ObjectMarshaler.MarshalLogObject(eventEncoder{e}); err != nil {
+		e.Str("error", err.Error())
+	}
+}
+
+// eventEncoder 将zerolog.Event适配为logger.ObjectEncoder
+type eventEncoder struct {
+	e *zerolog.Event
+}
+
+// AddString 实现logger.ObjectEncoder
+func (enc eventEncoder) AddString(key, value string) {
+	enc.e.Str(key, value)
+}
+
+// AddInt64 实现logger.ObjectEncoder
+func (enc eventEncoder) AddInt64(key string, value int64) {
+	enc.e.Int64(key, value)
+}
+
+// AddFloat64 实现logger.ObjectEncoder
+func (enc eventEncoder) AddFloat64(key string, value float64) {
+	enc.e.Float64(key, value)
+}
+
+// AddBool 实现logger.ObjectEncoder
+func (enc eventEncoder) AddBool(key string, value bool) {
+	enc.e.Bool(key, value)
+}
+
+// AddDuration 实现logger.ObjectEncoder
+func (enc eventEncoder) AddDuration(key string, value time.Duration) {
+	enc.e.Dur(key, value)
+}
+
+// AddTime 实现logger.ObjectEncoder
+func (enc eventEncoder) AddTime(key string, value time.Time) {
+	enc.e.Time(key, value)
+}
+
+// AddReflected 实现logger.ObjectEncoder
+func (enc eventEncoder) AddReflected(key string, value interface{}) error {
+	enc.e.Interface(key, value)
+	return nil
+}
+
+// zerologArray 将ArrayMarshaler适配为zerolog.LogArrayMarshaler
+type zerologArray struct {
+	logger.ArrayMarshaler
+}
+
+// MarshalZerologArray 实现zerolog.LogArrayMarshaler
+func (a zerologArray) MarshalZerologArray(arr *zerolog.Array) {
+	if err := a.ArrayMarshaler.MarshalLogArray(arrayEncoder{arr}); err != nil {
+		arr.Str(err.Error())
+	}
+}
+
+// arrayEncoder 将zerolog.Array适配为logger.ArrayEncoder
+type arrayEncoder struct {
+	arr *zerolog.Array
+}
+
+// AppendString 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendString(value string) {
+	enc.arr.Str(value)
+}
+
+// AppendInt64 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendInt64(value int64) {
+	enc.arr.Int64(value)
+}
+
+// AppendFloat64 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendFloat64(value float64) {
+	enc.arr.Float64(value)
+}
+
+// AppendBool 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendBool(value bool) {
+	enc.arr.Bool(value)
+}
+
+// AppendDuration 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendDuration(value time.Duration) {
+	enc.arr.Dur(value)
+}
+
+// AppendTime 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendTime(value time.Time) {
+	enc.arr.Time(value)
+}
+
+// AppendReflected 实现logger.ArrayEncoder
+func (enc arrayEncoder) AppendReflected(value interface{}) error {
+	enc.arr.Interface(value)
+	return nil
+}
+
+// ZerologLoggerProvider zerolog日志提供者
+type ZerologLoggerProvider struct{}
+
+// NewZerologLoggerProvider 创建zerolog日志提供者
+func NewZerologLoggerProvider() *ZerologLoggerProvider {
+	return &ZerologLoggerProvider{}
+}
+
+// Create 创建日志实例
+func (p *ZerologLoggerProvider) Create(name string, opts ...logger.Option) logger.Logger {
+	return NewZerologLogger(name, opts...)
+}
+
+// CreateWithConfig 根据配置创建日志实例
+// 配置值可以是字符串或数字，无法转换的项使用默认值并交给错误处理函数
+func (p *ZerologLoggerProvider) CreateWithConfig(name string, config map[string]interface{}) logger.Logger {
+	opts, err := logger.DecodeConfigMap(config)
+	logger.ReportError(err)
+	return NewZerologLogger(name, opts...)
+}
+
+// RegisterProvider 注册zerolog日志提供者
+func RegisterProvider() {
+	logger.GetLogFactory().RegisterProvider("zerolog", NewZerologLoggerProvider())
+}
+
+// init 自动注册zerolog日志提供者
+func init() {
+	RegisterProvider()
+}
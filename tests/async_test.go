@@ -0,0 +1,218 @@
+//go:build !windows
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// blockedOutput 创建一个命名管道作为日志输出，在startReader之前写入管道的后台协程会一直阻塞，异步队列因此被填满
+// 返回的函数开始读取管道，并在日志实例关闭输出后返回读到的所有行
+func blockedOutput(t *testing.T) (path string, startReader func() func() []string) {
+	t.Helper()
+	path = filepath.Join(t.TempDir(), "blocked.log")
+	if err := syscall.Mkfifo(path, 0600); err != nil {
+		t.Skipf("named pipes are not supported: %v", err)
+	}
+	return path, func() func() []string {
+		done := make(chan []byte, 1)
+		go func() {
+			f, err := os.Open(path)
+			if err != nil {
+				done <- nil
+				return
+			}
+			defer f.Close()
+			data, _ := io.ReadAll(f)
+			done <- data
+		}()
+		return func() []string {
+			select {
+			case data := <-done:
+				return strings.Split(strings.TrimSpace(string(data)), "\n")
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out reading the blocked output")
+				return nil
+			}
+		}
+	}
+}
+
+// closeLogger 关闭日志实例的输出，异步输出会先写完队列中的日志
+func closeLogger(t *testing.T, logger lclogface.Logger) {
+	t.Helper()
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitDequeued 等待后台协程取出第一条日志并阻塞在写入上
+func waitDequeued() {
+	time.Sleep(100 * time.Millisecond)
+}
+
+// TestAsyncDropNewest 测试队列已满时丢弃正在写入的日志
+func TestAsyncDropNewest(t *testing.T) {
+	path, startReader := blockedOutput(t)
+	logger := lclogface.GetLoggerWithProvider("async", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithAsync(2),
+		lclogface.WithOverflowPolicy(lclogface.OverflowDropNewest),
+	)
+	dropped := lclogface.DroppedEntries()
+	logger.Info("entry-0")
+	waitDequeued()
+	for i := 1; i < 6; i++ {
+		logger.Info(fmt.Sprintf("entry-%d", i))
+	}
+	lines := startReader()
+	closeLogger(t, logger)
+
+	got := lines()
+	if len(got) != 3 || !strings.HasSuffix(got[2], "entry-2") {
+		t.Errorf("Expected the first 3 entries to be written, got %v", got)
+	}
+	if n := lclogface.DroppedEntries() - dropped; n != 3 {
+		t.Errorf("Expected 3 dropped entries, got %d", n)
+	}
+}
+
+// TestAsyncDropOldest 测试队列已满时丢弃最早的日志
+func TestAsyncDropOldest(t *testing.T) {
+	path, startReader := blockedOutput(t)
+	logger := lclogface.GetLoggerWithProvider("async", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithAsync(2),
+		lclogface.WithOverflowPolicy(lclogface.OverflowDropOldest),
+	)
+	dropped := lclogface.DroppedEntries()
+	logger.Info("entry-0")
+	waitDequeued()
+	for i := 1; i < 6; i++ {
+		logger.Info(fmt.Sprintf("entry-%d", i))
+	}
+	lines := startReader()
+	closeLogger(t, logger)
+
+	got := lines()
+	if len(got) != 3 || !strings.HasSuffix(got[1], "entry-4") || !strings.HasSuffix(got[2], "entry-5") {
+		t.Errorf("Expected the first and the 2 newest entries to be written, got %v", got)
+	}
+	if n := lclogface.DroppedEntries() - dropped; n != 3 {
+		t.Errorf("Expected 3 dropped entries, got %d", n)
+	}
+}
+
+// TestAsyncDropBelowLevel 测试队列已满时只丢弃低于指定级别的日志
+func TestAsyncDropBelowLevel(t *testing.T) {
+	path, startReader := blockedOutput(t)
+	logger := lclogface.GetLoggerWithProvider("async", "std",
+		lclogface.WithOutputPath(path),
+		lclogface.WithAsync(1),
+		lclogface.WithOverflowPolicy(lclogface.OverflowDropBelowLevel),
+		lclogface.WithOverflowLevel(lclogface.ErrorLevel),
+	)
+	dropped := lclogface.DroppedEntries()
+	logger.Info("info-0")
+	waitDequeued()
+	logger.Error("error-1")
+	logger.Warn("warn-2")
+	logger.Info("info-3")
+	lines := startReader()
+	logger.Error("error-4") // 等待队列有空位
+	closeLogger(t, logger)
+
+	got := lines()
+	if len(got) != 3 || !strings.HasSuffix(got[1], "error-1") || !strings.HasSuffix(got[2], "error-4") {
+		t.Errorf("Expected errors to be kept, got %v", got)
+	}
+	if n := lclogface.DroppedEntries() - dropped; n != 2 {
+		t.Errorf("Expected 2 dropped entries, got %d", n)
+	}
+}
+
+// TestAsyncDropBelowLevelConcurrent 测试队列已满时并发写入的低级别日志都被丢弃，不会等待队列有空位
+func TestAsyncDropBelowLevelConcurrent(t *testing.T) {
+	for _, provider := range []string{"console", "std", "zap", "logrus", "slog", "zerolog"} {
+		t.Run(provider, func(t *testing.T) {
+			path, startReader := blockedOutput(t)
+			logger, err := lclogface.BuildLoggerWithProvider("async-"+provider, provider,
+				lclogface.WithOutputPath(path),
+				lclogface.WithAsync(1),
+				lclogface.WithOverflowPolicy(lclogface.OverflowDropBelowLevel),
+				lclogface.WithOverflowLevel(lclogface.ErrorLevel),
+			)
+			if err != nil {
+				t.Skipf("provider %s is not registered: %v", provider, err)
+			}
+			logger.Info("info-0")
+			waitDequeued()
+
+			// 队列只剩一个空位，同时写入的日志中最多一条入队，其余的必须丢弃而不是等待
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					logger.Warn(fmt.Sprintf("warn-%d", i))
+				}(i)
+			}
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(2 * time.Second):
+				t.Error("Expected entries below the overflow level not to block on a full queue")
+			}
+			lines := startReader()
+			closeLogger(t, logger)
+			if got := lines(); len(got) != 2 {
+				t.Errorf("Expected the first entry and one queued warning, got %v", got)
+			}
+		})
+	}
+}
+
+// TestShutdownDeadline 测试输出阻塞时Shutdown在ctx结束后返回
+func TestShutdownDeadline(t *testing.T) {
+	if path := os.Getenv(subprocessEnv); path != "" {
+		logger := lclogface.GetLoggerWithProvider("shutdown-blocked", "console",
+			lclogface.WithOutputPath(path),
+			lclogface.WithAsync(16),
+		)
+		logger.Info("blocked")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		err := lclogface.Shutdown(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected Shutdown to return the context error, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected Shutdown to return at the deadline, took %v", elapsed)
+		}
+		return
+	}
+
+	// 没有读取方时子进程打开管道会一直阻塞，异步输出因此无法关闭
+	path, _ := blockedOutput(t)
+	if code, output := runSubprocess(t, path); code != 0 {
+		t.Fatalf("Subprocess failed with code %d:\n%s", code, output)
+	}
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestAtomicLevelSharedWithChildren 测试派生实例与父实例共享级别
+func TestAtomicLevelSharedWithChildren(t *testing.T) {
+	for _, provider := range []string{"console", "std"} {
+		t.Run(provider, func(t *testing.T) {
+			log := lclogface.GetLoggerWithProvider("atomic-"+provider, provider)
+			child := log.WithField("k", "v").WithContext(t.Context())
+
+			log.SetLevel(lclogface.DebugLevel)
+			if !child.IsDebugEnabled() {
+				t.Error("Expected child to follow parent's level")
+			}
+
+			child.SetLevel(lclogface.ErrorLevel)
+			if log.GetLevel() != lclogface.ErrorLevel {
+				t.Errorf("Expected parent to follow child's level, got %v", log.GetLevel())
+			}
+
+			leveler, ok := log.(lclogface.AtomicLeveler)
+			if !ok {
+				t.Fatalf("Expected %s logger to expose its AtomicLevel", provider)
+			}
+			leveler.AtomicLevel().SetLevel(lclogface.WarnLevel)
+			if child.GetLevel() != lclogface.WarnLevel {
+				t.Errorf("Expected warn level, got %v", child.GetLevel())
+			}
+		})
+	}
+}
+
+// TestWithAtomicLevel 测试多个日志实例共享同一个级别
+func TestWithAtomicLevel(t *testing.T) {
+	level := lclogface.NewAtomicLevel(lclogface.WarnLevel)
+	a := lclogface.GetLoggerWithProvider("shared-a", "console", lclogface.WithAtomicLevel(level))
+	b := lclogface.GetLoggerWithProvider("shared-b", "std", lclogface.WithAtomicLevel(level))
+
+	if a.GetLevel() != lclogface.WarnLevel || b.GetLevel() != lclogface.WarnLevel {
+		t.Fatal("Expected loggers to use the shared level")
+	}
+
+	level.SetLevel(lclogface.DebugLevel)
+	if !a.IsDebugEnabled() || !b.IsDebugEnabled() {
+		t.Error("Expected both loggers to follow the shared level")
+	}
+}
+
+// TestAtomicLevelConcurrent 测试并发修改级别和输出日志，配合-race运行
+func TestAtomicLevelConcurrent(t *testing.T) {
+	log := lclogface.GetLoggerWithProvider("atomic-concurrent", "console",
+		lclogface.WithOutputPath(t.TempDir()+"/concurrent.log"))
+	child := log.WithField("worker", 1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				log.SetLevel(lclogface.LogLevel(j % 4))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				child.Debug("concurrent")
+				child.Infof("concurrent %d", j)
+			}
+		}()
+	}
+	wg.Wait()
+}
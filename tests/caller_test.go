@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// callerLine 返回调用处下一行的 "目录/文件:行号"
+func callerLine() string {
+	_, file, line, _ := runtime.Caller(1)
+	return filepath.Base(filepath.Dir(file)) + "/" + filepath.Base(file) + ":" + strconv.Itoa(line+1)
+}
+
+// logWrapped 模拟应用封装的日志函数
+func logWrapped(logger lclogface.Logger, msg string) {
+	logger.Info(msg)
+}
+
+// readLines 读取日志文件的所有行
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+// TestCaller 测试直接调用和全局函数输出的调用位置
+func TestCaller(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "caller.log")
+	logger := lclogface.GetLoggerWithProvider("caller", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithCaller(true),
+	)
+
+	direct := callerLine()
+	logger.Info("直接调用")
+
+	previous := lclogface.GetLogger()
+	lclogface.SetGlobalLogger(logger)
+	defer lclogface.SetGlobalLogger(previous)
+	global := callerLine()
+	lclogface.Info("全局函数")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], " "+direct+" tests.TestCaller 直接调用") {
+		t.Errorf("Expected caller %s, got %s", direct, lines[0])
+	}
+	if !strings.Contains(lines[1], " "+global+" tests.TestCaller 全局函数") {
+		t.Errorf("Expected caller %s, got %s", global, lines[1])
+	}
+}
+
+// TestCallerSkip 测试封装日志函数时跳过额外的调用层数
+func TestCallerSkip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "caller.log")
+	logger := lclogface.GetLoggerWithProvider("caller", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+		lclogface.WithCaller(true),
+		lclogface.WithCallerSkip(1),
+	)
+
+	expected := callerLine()
+	logWrapped(logger.WithField("k", "v"), "封装调用")
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(readLines(t, path)[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["caller"] != expected {
+		t.Errorf("Expected caller %s, got %v", expected, entry["caller"])
+	}
+	if !strings.HasSuffix(entry["func"].(string), "tests.TestCallerSkip") {
+		t.Errorf("Expected func TestCallerSkip, got %v", entry["func"])
+	}
+}
+
+// TestCallerDisabled 测试默认不输出调用位置
+func TestCallerDisabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "caller.log")
+	logger := lclogface.GetLoggerWithProvider("caller", "console", lclogface.WithOutputPath(path))
+	logger.Info("没有调用位置")
+
+	if line := readLines(t, path)[0]; strings.Contains(line, "caller_test.go") {
+		t.Errorf("Expected no caller, got %s", line)
+	}
+}
+
+// TestCallerLogConfig 测试通过LogConfig开启调用位置
+func TestCallerLogConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "caller.log")
+	config := lclogface.NewLogConfig().
+		WithName("caller-config").
+		WithProvider("console").
+		WithOutputPath(path).
+		WithCaller(true)
+
+	logger := lclogface.GetLoggerWithLogConfig(config)
+	expected := callerLine()
+	logger.Info("配置开启")
+
+	if line := readLines(t, path)[0]; !strings.Contains(line, " "+expected+" ") {
+		t.Errorf("Expected caller %s, got %s", expected, line)
+	}
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestEnvOverridesLogConfig 测试环境变量覆盖LogConfig
+func TestEnvOverridesLogConfig(t *testing.T) {
+	t.Setenv("LANDC_LOG_LEVEL", "error")
+	t.Setenv("LANDC_LOG_PAYMENTS_DB_LEVEL", "debug")
+
+	config := lclogface.NewLogConfig().WithName("payments.db").WithLevel(lclogface.WarnLevel)
+	payments := lclogface.GetLoggerWithLogConfig(config)
+	if !payments.IsDebugEnabled() {
+		t.Errorf("Expected name specific variable to win, got %v", payments.GetLevel())
+	}
+	if config.Level != lclogface.WarnLevel {
+		t.Error("Expected caller's config to be left unchanged")
+	}
+
+	orders := lclogface.GetLoggerWithLogConfig(lclogface.NewLogConfig().WithName("orders"))
+	if orders.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected LANDC_LOG_LEVEL to apply, got %v", orders.GetLevel())
+	}
+}
+
+// TestEnvOverridesMapAndProvider 测试环境变量覆盖配置map和选项
+func TestEnvOverridesMapAndProvider(t *testing.T) {
+	t.Setenv("LANDC_LOG_LEVEL", "warn")
+
+	fromMap := lclogface.GetLoggerWithMap("env-map", map[string]interface{}{
+		"provider": "std",
+		"level":    "debug",
+	})
+	if fromMap.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected map level to be overridden, got %v", fromMap.GetLevel())
+	}
+
+	fromOptions := lclogface.GetLoggerWithProvider("env-options", "console", lclogface.WithLevel(lclogface.DebugLevel))
+	if fromOptions.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected option level to be overridden, got %v", fromOptions.GetLevel())
+	}
+}
+
+// TestEnvOverridesReload 测试重新加载配置时环境变量仍然优先
+func TestEnvOverridesReload(t *testing.T) {
+	t.Setenv("LANDC_LOG_ENV_RELOAD_LEVEL", "error")
+
+	config := lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("env-reload").WithLevel(lclogface.DebugLevel))
+	lclogface.Configure(config)
+	defer lclogface.Configure(nil)
+
+	log := lclogface.GetLoggerWithName("env-reload")
+	if log.GetLevel() != lclogface.ErrorLevel {
+		t.Fatalf("Expected env level, got %v", log.GetLevel())
+	}
+
+	if err := lclogface.ReloadConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	if log.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected env level after reload, got %v", log.GetLevel())
+	}
+}
+
+// TestEnvOverridesOutputs 测试输出路径的环境变量替换配置的多输出
+func TestEnvOverridesOutputs(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "env.log")
+	configured := filepath.Join(dir, "configured.log")
+	t.Setenv("LANDC_LOG_OUTPUT", path)
+
+	loggers := []lclogface.Logger{
+		lclogface.GetLoggerWithProvider("env-outputs-options", "console",
+			lclogface.WithOutputs(lclogface.NewOutputConfig(configured))),
+		lclogface.GetLoggerWithLogConfig(lclogface.NewLogConfig().WithName("env-outputs-config").
+			WithOutputs(lclogface.NewOutputConfig(configured))),
+		lclogface.GetLoggerWithMap("env-outputs-map", map[string]interface{}{
+			"outputs": []interface{}{map[string]interface{}{"path": configured}},
+		}),
+	}
+	for _, log := range loggers {
+		log.Info("overridden")
+		log.Close()
+	}
+
+	if lines := readLines(t, path); len(lines) != len(loggers) {
+		t.Errorf("Expected every logger to write to the overridden path, got %v", lines)
+	}
+	if _, err := os.Stat(configured); !os.IsNotExist(err) {
+		t.Errorf("Expected the configured outputs to be replaced, got %v", err)
+	}
+}
+
+// TestEnvOverridesInvalid 测试非法的环境变量值
+func TestEnvOverridesInvalid(t *testing.T) {
+	t.Setenv("LANDC_LOG_LEVEL", "loud")
+
+	var reported error
+	lclogface.SetErrorHandler(func(err error) { reported = err })
+	defer lclogface.SetErrorHandler(nil)
+
+	log := lclogface.GetLoggerWithLogConfig(lclogface.NewLogConfig().WithName("env-invalid").WithLevel(lclogface.WarnLevel))
+	if log.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected configured level to be kept, got %v", log.GetLevel())
+	}
+	if reported == nil {
+		t.Fatal("Expected invalid variable to be reported")
+	}
+	if !strings.Contains(reported.Error(), "LANDC_LOG_LEVEL") {
+		t.Errorf("Expected error to name the variable, got %v", reported)
+	}
+}
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestLoadConfigFormats 测试从JSON、YAML、TOML加载配置
+func TestLoadConfigFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+	}{
+		{
+			name:   "json",
+			format: lclogface.ConfigFormatJSON,
+			content: `{
+				"provider": "std",
+				"level": "debug",
+				"format": "json",
+				"maxLogSize": "50MB",
+				"maxLogAge": "3d",
+				"maxMessageSize": "10KB"
+			}`,
+		},
+		{
+			name:   "yaml",
+			format: lclogface.ConfigFormatYAML,
+			content: `
+provider: std
+level: DEBUG
+format: json
+maxLogSize: 50
+maxLogAge: 72h
+maxMessageSize: 10
+`,
+		},
+		{
+			name:   "toml",
+			format: lclogface.ConfigFormatTOML,
+			content: `
+provider = "std"
+level = "debug"
+format = "json"
+maxLogSize = "50MB"
+maxLogAge = "3d"
+maxMessageSize = "10KB"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := lclogface.LoadConfig(strings.NewReader(tt.content), tt.format)
+			if err != nil {
+				t.Fatalf("加载配置失败: %v", err)
+			}
+
+			if config.Provider != "std" {
+				t.Errorf("Expected provider 'std', got '%s'", config.Provider)
+			}
+			if config.Level != lclogface.DebugLevel {
+				t.Errorf("Expected level DebugLevel, got %v", config.Level)
+			}
+			if config.Format != "json" {
+				t.Errorf("Expected format 'json', got '%s'", config.Format)
+			}
+			if config.MaxLogSize != 50 {
+				t.Errorf("Expected maxLogSize 50, got %d", config.MaxLogSize)
+			}
+			if config.MaxLogAge != 72*time.Hour {
+				t.Errorf("Expected maxLogAge 72h, got %v", config.MaxLogAge)
+			}
+			if config.MaxMessageSize != 10 {
+				t.Errorf("Expected maxMessageSize 10, got %d", config.MaxMessageSize)
+			}
+
+			// 未配置的项保留默认值
+			if config.OutputPath != "stdout" {
+				t.Errorf("Expected default output path 'stdout', got '%s'", config.OutputPath)
+			}
+			if config.MaxLogFiles != 10 {
+				t.Errorf("Expected default maxLogFiles 10, got %d", config.MaxLogFiles)
+			}
+		})
+	}
+}
+
+// TestLoadConfigFile 测试根据扩展名加载配置文件
+func TestLoadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logging.yml")
+	content := "name: payments\nlevel: warn\nmaxLogAge: 1w\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := lclogface.LoadConfigFile(path)
+	if err != nil {
+		t.Fatalf("加载配置文件失败: %v", err)
+	}
+	if config.Name != "payments" || config.Level != lclogface.WarnLevel {
+		t.Errorf("Unexpected config: name=%s level=%v", config.Name, config.Level)
+	}
+	if config.MaxLogAge != 7*24*time.Hour {
+		t.Errorf("Expected maxLogAge 1w, got %v", config.MaxLogAge)
+	}
+
+	if _, err := lclogface.LoadConfigFile(filepath.Join(t.TempDir(), "logging.ini")); err == nil {
+		t.Error("Expected error for unknown config format")
+	}
+}
+
+// TestLoadConfigErrors 测试非法配置值
+func TestLoadConfigErrors(t *testing.T) {
+	invalid := []string{
+		`{"level": "verbose"}`,
+		`{"maxLogAge": "seven days"}`,
+		`{"maxLogSize": "100XB"}`,
+	}
+
+	for _, content := range invalid {
+		if _, err := lclogface.LoadConfig(strings.NewReader(content), lclogface.ConfigFormatJSON); err == nil {
+			t.Errorf("Expected error for %s", content)
+		}
+	}
+}
+
+// TestLogConfigJSONRoundTrip 测试LogConfig的JSON编解码
+func TestLogConfigJSONRoundTrip(t *testing.T) {
+	config := lclogface.NewLogConfig().
+		WithLevel(lclogface.ErrorLevel).
+		WithMaxLogAge(14 * 24 * time.Hour).
+		WithMaxLogSize(200)
+
+	data, err := config.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"level":"error"`, `"maxLogAge":"14d"`, `"maxLogSize":"200MB"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected %s in %s", want, data)
+		}
+	}
+
+	decoded, err := lclogface.LoadConfig(strings.NewReader(string(data)), lclogface.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Level != config.Level || decoded.MaxLogAge != config.MaxLogAge || decoded.MaxLogSize != config.MaxLogSize {
+		t.Errorf("Round trip mismatch: %+v", decoded)
+	}
+}
+
+// TestParseLevel 测试级别解析
+func TestParseLevel(t *testing.T) {
+	testCases := map[string]lclogface.LogLevel{
+		"debug":   lclogface.DebugLevel,
+		"Info":    lclogface.InfoLevel,
+		"WARNING": lclogface.WarnLevel,
+		"error":   lclogface.ErrorLevel,
+		"fatal":   lclogface.FatalLevel,
+		"panic":   lclogface.PanicLevel,
+	}
+
+	for text, expected := range testCases {
+		level, err := lclogface.ParseLevel(text)
+		if err != nil || level != expected {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", text, level, err, expected)
+		}
+	}
+
+	if _, err := lclogface.ParseLevel("verbose"); err == nil {
+		t.Error("Expected error for unknown level")
+	}
+}
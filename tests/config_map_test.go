@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestDecodeConfigMap 测试配置map的类型转换
+func TestDecodeConfigMap(t *testing.T) {
+	var config map[string]interface{}
+	content := `{
+		"level": "debug",
+		"format": "json",
+		"maxLogSize": 50,
+		"maxLogAge": "24h",
+		"maxLogFiles": 3,
+		"compressLogs": "true",
+		"maxMessageSize": "10KB"
+	}`
+	if err := json.Unmarshal([]byte(content), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	opts, err := lclogface.DecodeConfigMap(config)
+	if err != nil {
+		t.Fatalf("转换配置失败: %v", err)
+	}
+
+	options := &lclogface.LoggerOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.Level != lclogface.DebugLevel || options.Format != "json" {
+		t.Errorf("Unexpected level/format: %v %s", options.Level, options.Format)
+	}
+	if options.MaxLogSize != 50 || options.MaxLogAge != 24*time.Hour || options.MaxLogFiles != 3 {
+		t.Errorf("Unexpected rotation options: %+v", options)
+	}
+	if !options.CompressLogs || options.MaxMessageSize != 10 {
+		t.Errorf("Unexpected compress/maxMessageSize: %v %d", options.CompressLogs, options.MaxMessageSize)
+	}
+}
+
+// TestDecodeConfigMapErrors 测试无法转换的配置值
+func TestDecodeConfigMapErrors(t *testing.T) {
+	_, err := lclogface.DecodeConfigMap(map[string]interface{}{
+		"level":        "loud",
+		"maxLogAge":    "a week",
+		"maxLogFiles":  2.5,
+		"compressLogs": "sometimes",
+	})
+	if !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+	}
+	for _, key := range []string{"level", "maxLogAge", "maxLogFiles", "compressLogs"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Expected error to mention %s, got %v", key, err)
+		}
+	}
+}
+
+// TestCreateLoggerWithJSONMap 测试提供者使用JSON解码出的配置map
+func TestCreateLoggerWithJSONMap(t *testing.T) {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"provider": "std", "level": "error"}`), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	log := lclogface.GetLoggerWithMap("json-map", config)
+	if log.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected error level from string, got %v", log.GetLevel())
+	}
+
+	var reported error
+	lclogface.SetErrorHandler(func(err error) { reported = err })
+	defer lclogface.SetErrorHandler(nil)
+
+	log = lclogface.GetLoggerWithMap("bad-map", map[string]interface{}{"provider": "console", "level": "loud"})
+	if log.GetLevel() != lclogface.InfoLevel || reported == nil {
+		t.Errorf("Expected default level and a reported error, got %v, %v", log.GetLevel(), reported)
+	}
+
+	if _, err := lclogface.BuildLoggerWithMap("bad-map", map[string]interface{}{"provider": "console", "level": "loud"}); err == nil {
+		t.Error("Expected BuildLoggerWithMap to return the conversion error")
+	}
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestReloadConfig 测试重新加载配置后已有的日志实例立即生效
+func TestReloadConfig(t *testing.T) {
+	config := lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("reload").WithProvider("std").WithLevel(lclogface.InfoLevel))
+	lclogface.Configure(config)
+	defer lclogface.Configure(nil)
+
+	log := lclogface.GetLoggerWithName("reload")
+	child := log.WithField("component", "worker")
+	if log.IsDebugEnabled() || child.IsDebugEnabled() {
+		t.Fatal("Expected debug to be disabled before reload")
+	}
+
+	updated := lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("reload").WithProvider("std").WithLevel(lclogface.DebugLevel))
+	if err := lclogface.ReloadConfig(updated); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+
+	if !log.IsDebugEnabled() || !child.IsDebugEnabled() {
+		t.Error("Expected existing logger and its children to use the reloaded level")
+	}
+	if lclogface.GetLoggerWithName("reload") != log {
+		t.Error("Expected named logger to be reused after reload")
+	}
+}
+
+// TestReloadAfterClose 测试按名称创建的实例关闭后重新创建，重新加载配置对新实例生效
+func TestReloadAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "closed.log")
+	config := lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("reload-closed").WithProvider("std").WithLevel(lclogface.InfoLevel).WithOutputPath(path))
+	lclogface.Configure(config)
+	defer lclogface.Configure(nil)
+
+	log := lclogface.GetLoggerWithName("reload-closed")
+	if err := log.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reopened := lclogface.GetLoggerWithName("reload-closed")
+	if reopened == log {
+		t.Fatal("Expected a closed named logger to be recreated")
+	}
+	reopened.Info("after close")
+	if lines := readLines(t, path); len(lines) != 1 || !strings.Contains(lines[0], "after close") {
+		t.Errorf("Expected the recreated logger to write to the file, got %v", lines)
+	}
+
+	updated := lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("reload-closed").WithProvider("std").WithLevel(lclogface.DebugLevel).WithOutputPath(path))
+	if err := lclogface.ReloadConfig(updated); err != nil {
+		t.Fatal(err)
+	}
+	if !reopened.IsDebugEnabled() {
+		t.Error("Expected the recreated logger to use the reloaded level")
+	}
+	if lclogface.GetLoggerWithName("reload-closed") != reopened {
+		t.Error("Expected the recreated logger to be reused")
+	}
+	reopened.Close()
+}
+
+// TestApplyLogConfig 测试将单个配置应用到同名日志实例
+func TestApplyLogConfig(t *testing.T) {
+	log := lclogface.GetLoggerWithLogConfig(lclogface.NewLogConfig().WithName("apply").WithProvider("console"))
+	if log.IsDebugEnabled() {
+		t.Fatal("Expected default info level")
+	}
+
+	if err := lclogface.ApplyLogConfig(lclogface.NewLogConfig().WithName("apply").WithProvider("console").WithLevel(lclogface.DebugLevel)); err != nil {
+		t.Fatal(err)
+	}
+	if !log.IsDebugEnabled() {
+		t.Error("Expected debug level after ApplyLogConfig")
+	}
+}
+
+// TestTrackedLoggers 测试日志工厂继续跟踪只剩派生实例的日志实例，不再跟踪已关闭的日志实例
+func TestTrackedLoggers(t *testing.T) {
+	countTracked := func(prefix string) int {
+		count := 0
+		for _, info := range lclogface.Loggers() {
+			if strings.HasPrefix(info.Name, prefix) {
+				count++
+			}
+		}
+		return count
+	}
+
+	// 只保留派生实例，原实例被回收后仍能按名称找到并重新配置
+	derived := lclogface.GetLoggerWithProvider("tracked-derived", "console").WithField("k", "v")
+	runtime.GC()
+	runtime.GC()
+	if countTracked("tracked-derived") != 1 {
+		t.Fatal("Expected the logger behind a derived logger to stay tracked")
+	}
+	config := lclogface.NewLogConfig().WithName("tracked-derived").WithLevel(lclogface.ErrorLevel)
+	if err := lclogface.ApplyLogConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	if derived.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected the derived logger to be reconfigured, got %v", derived.GetLevel())
+	}
+
+	log := lclogface.GetLoggerWithProvider("tracked-closed", "std")
+	if countTracked("tracked-closed") != 1 {
+		t.Fatal("Expected the logger to be tracked")
+	}
+	if err := log.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if countTracked("tracked-closed") != 0 {
+		t.Error("Expected closed loggers to be untracked")
+	}
+}
+
+// TestReconfigureOutput 测试运行时切换输出位置和格式
+func TestReconfigureOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reconfigure.log")
+
+	for _, provider := range []string{"console", "std"} {
+		t.Run(provider, func(t *testing.T) {
+			log := lclogface.GetLoggerWithProvider("reconfigure-"+provider, provider)
+			reconfigurable, ok := log.(lclogface.Reconfigurable)
+			if !ok {
+				t.Fatalf("Expected %s logger to be reconfigurable", provider)
+			}
+
+			err := reconfigurable.Reconfigure(
+				lclogface.WithOutputPath(path),
+				lclogface.WithFormat("json"),
+				lclogface.WithLevel(lclogface.DebugLevel),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			log.Debug("reconfigured " + provider)
+			log.Sync()
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), "reconfigured "+provider) {
+				t.Errorf("Expected log file to contain message, got %s", data)
+			}
+
+			// 切回标准输出，释放文件
+			reconfigurable.Reconfigure(lclogface.WithOutputPath("stdout"))
+		})
+	}
+}
+
+// TestWatchConfigFile 测试配置文件变化后自动重新加载
+func TestWatchConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logging.yaml")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("loggers:\n  - name: watched\n    provider: std\n    level: warn\n")
+
+	watcher, err := lclogface.WatchConfigFile(path, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("监视配置文件失败: %v", err)
+	}
+	defer lclogface.Configure(nil)
+	defer watcher.Stop()
+
+	log := lclogface.GetLoggerWithName("watched")
+	if log.GetLevel() != lclogface.WarnLevel {
+		t.Fatalf("Expected warn level, got %v", log.GetLevel())
+	}
+
+	write("loggers:\n  - name: watched\n    provider: std\n    level: debug\n    format: json\n")
+
+	deadline := time.Now().Add(2 * time.Second)
+	for log.GetLevel() != lclogface.DebugLevel {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected debug level after file change, got %v", log.GetLevel())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
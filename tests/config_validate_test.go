@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestValidateStrict 测试严格验证返回所有非法的配置项
+func TestValidateStrict(t *testing.T) {
+	if err := lclogface.NewLogConfig().ValidateStrict(); err != nil {
+		t.Errorf("Expected default config to be valid, got %v", err)
+	}
+
+	config := lclogface.NewLogConfig().WithFormat("jsno").WithMaxLogSize(-1).WithMaxLogFiles(-3)
+	err := config.ValidateStrict()
+	if !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+	}
+	for _, want := range []string{"jsno", "maxLogSize", "maxLogFiles"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to mention %s, got %v", want, err)
+		}
+	}
+	if config.Format != "jsno" {
+		t.Error("Expected ValidateStrict to leave the config unchanged")
+	}
+}
+
+// TestBuildLogger 测试严格创建日志实例
+func TestBuildLogger(t *testing.T) {
+	if _, err := lclogface.BuildLoggerWithLogConfig(lclogface.NewLogConfig().WithFormat("xml")); !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+	if _, err := lclogface.BuildLoggerWithProvider("strict", "zapp"); !errors.Is(err, lclogface.ErrUnknownProvider) {
+		t.Errorf("Expected ErrUnknownProvider, got %v", err)
+	}
+	if _, err := lclogface.BuildLoggerWithMap("strict", map[string]interface{}{"provider": "logruss"}); !errors.Is(err, lclogface.ErrUnknownProvider) {
+		t.Errorf("Expected ErrUnknownProvider, got %v", err)
+	}
+
+	log, err := lclogface.BuildLoggerWithLogConfig(lclogface.NewLogConfig().WithProvider("std").WithFormat("json"))
+	if err != nil || log == nil {
+		t.Fatalf("Expected valid config to build, got %v", err)
+	}
+}
+
+// TestStrictMode 测试严格模式下拒绝非法配置
+func TestStrictMode(t *testing.T) {
+	// 非严格模式下回退到默认提供者
+	if log := lclogface.GetLoggerWithProvider("lenient", "zapp"); log == nil {
+		t.Fatal("Expected fallback logger")
+	}
+
+	lclogface.SetStrict(true)
+	defer lclogface.SetStrict(false)
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, lclogface.ErrUnknownProvider) {
+			t.Errorf("Expected panic with ErrUnknownProvider, got %v", err)
+		}
+	}()
+	lclogface.GetLoggerWithProvider("strict", "zapp")
+}
+
+// TestStrictGlobalLogger 测试严格模式下创建全局日志实例失败后，之后的调用返回后备实例
+func TestStrictGlobalLogger(t *testing.T) {
+	if os.Getenv(subprocessEnv) == "" {
+		if code, output := runSubprocess(t, "strict"); code != 0 {
+			t.Fatalf("Subprocess failed with code %d:\n%s", code, output)
+		}
+		return
+	}
+
+	lclogface.SetStrict(true)
+	lclogface.Configure(lclogface.NewLoggingConfig().
+		WithLogger(lclogface.NewLogConfig().WithName("global").WithProvider("zapp")))
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected GetLogger to panic for an invalid definition")
+			}
+		}()
+		lclogface.GetLogger()
+	}()
+	if lclogface.GetLogger() == nil {
+		t.Fatal("Expected a fallback global logger after the panic")
+	}
+	lclogface.Info("global logger still works")
+}
+
+// TestLoadConfigStrict 测试从配置文件加载时报告非法的值
+func TestLoadConfigStrict(t *testing.T) {
+	_, err := lclogface.LoadConfig(strings.NewReader(`{"format": "jsn", "maxLogFiles": -1}`), lclogface.ConfigFormatJSON)
+	if !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+
+	_, err = lclogface.LoadLoggingConfig(strings.NewReader(`{"loggers": [{"name": "a", "format": "xml"}]}`), lclogface.ConfigFormatJSON)
+	if err == nil || !strings.Contains(err.Error(), `"a"`) {
+		t.Errorf("Expected error for logger a, got %v", err)
+	}
+}
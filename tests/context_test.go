@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// sessionKey 测试自定义提取器使用的上下文键
+type sessionKey struct{}
+
+// TestContextFields 测试从上下文提取内置字段
+func TestContextFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "context.log")
+	logger := lclogface.GetLoggerWithProvider("context", "console", lclogface.WithOutputPath(path))
+
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	ctx = lclogface.ContextWithRequestID(ctx, "req-1")
+	ctx = lclogface.ContextWithUserID(ctx, "user-1")
+	ctx = lclogface.ContextWithTenantID(ctx, "tenant-1")
+
+	logger.WithContext(ctx).Info("绑定上下文")
+	logger.InfoContext(lclogface.ContextWithTraceID(context.Background(), "trace-2"), "单次调用", lclogface.String("k", "v"))
+	logger.Info("没有上下文")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %s", len(lines), data)
+	}
+	if !strings.HasSuffix(lines[0], "绑定上下文 trace_id=trace-1 request_id=req-1 user_id=user-1 tenant_id=tenant-1") {
+		t.Errorf("Expected context fields on bound logger, got %s", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "单次调用 trace_id=trace-2 k=v") {
+		t.Errorf("Expected context fields before call fields, got %s", lines[1])
+	}
+	if strings.Contains(lines[2], "trace_id") {
+		t.Errorf("Expected no context fields, got %s", lines[2])
+	}
+
+	if lclogface.TraceIDFromContext(ctx) != "trace-1" || lclogface.TenantIDFromContext(ctx) != "tenant-1" {
+		t.Error("Expected IDs to be readable from context")
+	}
+}
+
+// TestContextExtractor 测试注册自定义上下文提取器
+func TestContextExtractor(t *testing.T) {
+	lclogface.RegisterContextExtractor("session", lclogface.ContextKeyExtractor("session_id", sessionKey{}))
+	defer lclogface.UnregisterContextExtractor("session")
+
+	ctx := context.WithValue(context.Background(), sessionKey{}, "s-42")
+	fields := lclogface.ContextFields(ctx)
+	if len(fields) != 1 || fields[0].Key != "session_id" || fields[0].Any() != "s-42" {
+		t.Errorf("Expected session_id field, got %v", fields)
+	}
+
+	lclogface.UnregisterContextExtractor("session")
+	if fields := lclogface.ContextFields(ctx); len(fields) != 0 {
+		t.Errorf("Expected no fields after unregistering, got %v", fields)
+	}
+}
+
+// TestContextFieldsFiltered 测试级别被过滤时不调用提取器
+func TestContextFieldsFiltered(t *testing.T) {
+	called := false
+	lclogface.RegisterContextExtractor("probe", func(ctx context.Context) []lclogface.Field {
+		called = true
+		return nil
+	})
+	defer lclogface.UnregisterContextExtractor("probe")
+
+	logger := lclogface.GetLoggerWithProvider("context-filtered", "console", lclogface.WithLevel(lclogface.WarnLevel))
+	logger.DebugContext(context.Background(), "被过滤")
+	if called {
+		t.Error("Expected extractors not to run for disabled levels")
+	}
+}
+
+// TestLoggerContext 测试在上下文中保存和取出日志实例
+func TestLoggerContext(t *testing.T) {
+	if lclogface.FromContext(context.Background()) != lclogface.GetLogger() {
+		t.Error("Expected FromContext to fall back to the global logger")
+	}
+	if _, ok := lclogface.LoggerFromContext(context.Background()); ok {
+		t.Error("Expected no logger in empty context")
+	}
+
+	logger := lclogface.GetLoggerWithProvider("context-logger", "console").WithField("request", "r1")
+	ctx := lclogface.NewContext(context.Background(), logger)
+	if lclogface.FromContext(ctx) != logger {
+		t.Error("Expected FromContext to return the stored logger")
+	}
+}
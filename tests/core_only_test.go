@@ -1,4 +1,4 @@
-//go:build !zap_provider && !logrus_provider && !gin_provider && !gf_provider
+//go:build !zap_provider && !logrus_provider && !gin_provider && !gf_provider && !slog_provider && !zerolog_provider
 
 package tests
 
@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// noticeLevel 测试使用的自定义级别，介于Info和Warn之间
+var noticeLevel = lclogface.MustRegisterLevel("NOTICE", lclogface.InfoLevel.Severity()+5)
+
+// TestTraceLevel 测试跟踪级别的过滤和输出
+func TestTraceLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.log")
+	logger := lclogface.GetLoggerWithProvider("trace", "console", lclogface.WithOutputPath(path))
+
+	logger.Trace("隐藏的跟踪日志")
+	if logger.IsTraceEnabled() {
+		t.Error("Expected trace to be disabled at info level")
+	}
+
+	logger.SetLevel(lclogface.TraceLevel)
+	if !logger.IsTraceEnabled() || !logger.IsDebugEnabled() {
+		t.Error("Expected trace and debug to be enabled at trace level")
+	}
+	logger.Tracef("跟踪 %d", 1)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	if strings.Contains(output, "隐藏的跟踪日志") {
+		t.Errorf("Expected trace log to be filtered, got %s", output)
+	}
+	if !strings.Contains(output, "[TRACE] [trace] 跟踪 1") {
+		t.Errorf("Expected trace log in output, got %s", output)
+	}
+
+	if level, err := lclogface.ParseLevel("trace"); err != nil || level != lclogface.TraceLevel {
+		t.Errorf("Expected ParseLevel(trace) to return TraceLevel, got %v, %v", level, err)
+	}
+}
+
+// TestCustomLevel 测试自定义级别的注册、解析和输出
+func TestCustomLevel(t *testing.T) {
+	if noticeLevel.String() != "NOTICE" {
+		t.Errorf("Expected NOTICE, got %s", noticeLevel)
+	}
+	if level, err := lclogface.ParseLevel("notice"); err != nil || level != noticeLevel {
+		t.Errorf("Expected ParseLevel(notice) to return the custom level, got %v, %v", level, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "notice.log")
+	logger := lclogface.GetLoggerWithProvider("notice", "console", lclogface.WithOutputPath(path))
+	logger.Log(noticeLevel, "配置已变更", lclogface.Field{Key: "key", Value: "timeout"})
+
+	logger.SetLevel(lclogface.WarnLevel)
+	if logger.IsLevelEnabled(noticeLevel) {
+		t.Error("Expected notice to be disabled at warn level")
+	}
+	logger.Logf(noticeLevel, "隐藏的%s", "通知")
+
+	logger.SetLevel(noticeLevel)
+	if logger.IsInfoEnabled() || !logger.IsWarnEnabled() {
+		t.Error("Expected notice level to sit between info and warn")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	if !strings.Contains(output, "[NOTICE] [notice] 配置已变更 key=timeout") {
+		t.Errorf("Expected notice log in output, got %s", output)
+	}
+	if strings.Contains(output, "隐藏的通知") {
+		t.Errorf("Expected notice log to be filtered, got %s", output)
+	}
+}
+
+// TestRegisterLevelConflicts 测试注册自定义级别时的冲突检查
+func TestRegisterLevelConflicts(t *testing.T) {
+	if level, err := lclogface.RegisterLevel("notice", lclogface.InfoLevel.Severity()+5); err != nil || level != noticeLevel {
+		t.Errorf("Expected re-registering the same level to return it, got %v, %v", level, err)
+	}
+
+	testCases := []struct {
+		name     string
+		severity int
+	}{
+		{"NOTICE", lclogface.InfoLevel.Severity() + 6},
+		{"AUDIT2", lclogface.InfoLevel.Severity() + 5},
+		{"WARNING", lclogface.WarnLevel.Severity() + 1},
+		{"AUDIT2", lclogface.ErrorLevel.Severity()},
+		{"", lclogface.ErrorLevel.Severity() + 1},
+		{"42", lclogface.ErrorLevel.Severity() + 2},
+	}
+	for _, tc := range testCases {
+		if _, err := lclogface.RegisterLevel(tc.name, tc.severity); err == nil {
+			t.Errorf("Expected RegisterLevel(%q, %d) to fail", tc.name, tc.severity)
+		}
+	}
+}
+
+// TestCustomLevelConfig 测试在配置中使用自定义级别
+func TestCustomLevelConfig(t *testing.T) {
+	config, err := lclogface.LoadConfig(strings.NewReader(`{"name": "notice-config", "level": "notice"}`), lclogface.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Level != noticeLevel {
+		t.Errorf("Expected notice level, got %v", config.Level)
+	}
+	if err := config.ValidateStrict(); err != nil {
+		t.Errorf("Expected custom level to pass strict validation, got %v", err)
+	}
+}
+
+// TestNumericLevels 测试数字形式的级别：内置级别保持早期版本的数值，其他数字必须是已注册的自定义级别
+func TestNumericLevels(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected lclogface.LogLevel
+	}{
+		{"-1", lclogface.TraceLevel},
+		{"0", lclogface.DebugLevel},
+		{"1", lclogface.InfoLevel},
+		{"3", lclogface.ErrorLevel},
+		{"5", lclogface.PanicLevel},
+		{strconv.Itoa(int(noticeLevel)), noticeLevel},
+	}
+	for _, tc := range testCases {
+		if level, err := lclogface.ParseLevel(tc.text); err != nil || level != tc.expected {
+			t.Errorf("ParseLevel(%q) = %v, %v, expected %v", tc.text, level, err, tc.expected)
+		}
+	}
+	for _, text := range []string{"7", "-2", "10", "99"} {
+		if _, err := lclogface.ParseLevel(text); err == nil {
+			t.Errorf("Expected ParseLevel(%q) to fail", text)
+		}
+	}
+
+	// 级别的先后按严重程度比较，自定义级别的数值不影响先后
+	log := lclogface.GetLoggerWithProvider("numeric-severity", "console", lclogface.WithLevel(noticeLevel))
+	if log.IsInfoEnabled() || !log.IsWarnEnabled() {
+		t.Errorf("Expected notice to sit between info and warn")
+	}
+	log.SetLevel(lclogface.LogLevel(2))
+	if log.GetLevel() != lclogface.WarnLevel || log.IsInfoEnabled() {
+		t.Errorf("Expected level 2 to mean warn, got %v", log.GetLevel())
+	}
+
+	config, err := lclogface.LoadConfig(strings.NewReader(`{"level": 3}`), lclogface.ConfigFormatJSON)
+	if err != nil || config.Level != lclogface.ErrorLevel {
+		t.Errorf("Expected level 3 in JSON to mean error, got %v, %v", config, err)
+	}
+	if _, err := lclogface.LoadConfig(strings.NewReader(`{"level": 7}`), lclogface.ConfigFormatJSON); err == nil {
+		t.Error("Expected unknown numeric level in JSON to fail")
+	}
+
+	built, err := lclogface.BuildLoggerWithMap("numeric-level", map[string]interface{}{"provider": "console", "level": 3})
+	if err != nil || built.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected level 3 in config map to mean error, got %v", err)
+	}
+	if _, err := lclogface.BuildLoggerWithMap("numeric-level", map[string]interface{}{"provider": "console", "level": 7}); err == nil {
+		t.Error("Expected unknown numeric level in config map to fail")
+	}
+}
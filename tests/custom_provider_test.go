@@ -18,47 +18,107 @@ func NewCustomLogger(name string) *CustomLogger {
 	return &CustomLogger{name: name}
 }
 
+// Trace 输出跟踪级日志
+func (c *CustomLogger) Trace(msg string, fields ...lclogface.Field) {}
+
+// Tracef 输出格式化的跟踪级日志
+func (c *CustomLogger) Tracef(format string, args ...interface{}) {}
+
+// Tracew 输出跟踪级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Tracew(msg string, keysAndValues ...interface{}) {}
+
 // Debug 输出调试级日志
 func (c *CustomLogger) Debug(msg string, fields ...lclogface.Field) {}
 
 // Debugf 输出格式化的调试级日志
 func (c *CustomLogger) Debugf(format string, args ...interface{}) {}
 
+// Debugw 输出调试级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Debugw(msg string, keysAndValues ...interface{}) {}
+
 // Info 输出信息级日志
 func (c *CustomLogger) Info(msg string, fields ...lclogface.Field) {}
 
 // Infof 输出格式化的信息级日志
 func (c *CustomLogger) Infof(format string, args ...interface{}) {}
 
+// Infow 输出信息级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Infow(msg string, keysAndValues ...interface{}) {}
+
 // Warn 输出警告级日志
 func (c *CustomLogger) Warn(msg string, fields ...lclogface.Field) {}
 
 // Warnf 输出格式化的警告级日志
 func (c *CustomLogger) Warnf(format string, args ...interface{}) {}
 
+// Warnw 输出警告级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Warnw(msg string, keysAndValues ...interface{}) {}
+
 // Error 输出错误级日志
 func (c *CustomLogger) Error(msg string, fields ...lclogface.Field) {}
 
 // Errorf 输出格式化的错误级日志
 func (c *CustomLogger) Errorf(format string, args ...interface{}) {}
 
+// Errorw 输出错误级日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Errorw(msg string, keysAndValues ...interface{}) {}
+
 // Fatal 输出致命级日志并退出程序
 func (c *CustomLogger) Fatal(msg string, fields ...lclogface.Field) {}
 
 // Fatalf 输出格式化的致命级日志并退出程序
 func (c *CustomLogger) Fatalf(format string, args ...interface{}) {}
 
+// Fatalw 输出致命级日志并退出程序，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Fatalw(msg string, keysAndValues ...interface{}) {}
+
 // Panic 输出恐慌级日志并触发panic
 func (c *CustomLogger) Panic(msg string, fields ...lclogface.Field) {}
 
 // Panicf 输出格式化的恐慌级日志并触发panic
 func (c *CustomLogger) Panicf(format string, args ...interface{}) {}
 
+// Panicw 输出恐慌级日志并触发panic，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Panicw(msg string, keysAndValues ...interface{}) {}
+
+// Log 按指定级别输出日志
+func (c *CustomLogger) Log(level lclogface.LogLevel, msg string, fields ...lclogface.Field) {}
+
+// Logf 按指定级别输出格式化的日志
+func (c *CustomLogger) Logf(level lclogface.LogLevel, format string, args ...interface{}) {}
+
+// Logw 按指定级别输出日志，keysAndValues为交替出现的键和值
+func (c *CustomLogger) Logw(level lclogface.LogLevel, msg string, keysAndValues ...interface{}) {}
+
+// TraceContext 输出跟踪级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) TraceContext(ctx context.Context, msg string, fields ...lclogface.Field) {}
+
+// DebugContext 输出调试级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) DebugContext(ctx context.Context, msg string, fields ...lclogface.Field) {}
+
+// InfoContext 输出信息级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) InfoContext(ctx context.Context, msg string, fields ...lclogface.Field) {}
+
+// WarnContext 输出警告级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) WarnContext(ctx context.Context, msg string, fields ...lclogface.Field) {}
+
+// ErrorContext 输出错误级日志，并输出从ctx中提取的字段
+func (c *CustomLogger) ErrorContext(ctx context.Context, msg string, fields ...lclogface.Field) {}
+
+// LogContext 按指定级别输出日志，并输出从ctx中提取的字段
+func (c *CustomLogger) LogContext(ctx context.Context, level lclogface.LogLevel, msg string, fields ...lclogface.Field) {
+}
+
 // WithFields 添加字段到日志
 func (c *CustomLogger) WithFields(fields ...lclogface.Field) lclogface.Logger {
 	return c
 }
 
+// Named 创建子日志实例
+func (c *CustomLogger) Named(name string) lclogface.Logger {
+	return c
+}
+
 // WithField 添加单个字段到日志
 func (c *CustomLogger) WithField(key string, value interface{}) lclogface.Logger {
 	return c
@@ -79,6 +139,11 @@ func (c *CustomLogger) WithTime(t time.Time) lclogface.Logger {
 	return c
 }
 
+// WithStack 为每条日志附加调用堆栈
+func (c *CustomLogger) WithStack() lclogface.Logger {
+	return c
+}
+
 // SetLevel 设置日志级别
 func (c *CustomLogger) SetLevel(level lclogface.LogLevel) {}
 
@@ -87,6 +152,11 @@ func (c *CustomLogger) GetLevel() lclogface.LogLevel {
 	return lclogface.InfoLevel
 }
 
+// IsTraceEnabled 检查跟踪级别是否启用
+func (c *CustomLogger) IsTraceEnabled() bool {
+	return false
+}
+
 // IsDebugEnabled 检查调试级别是否启用
 func (c *CustomLogger) IsDebugEnabled() bool {
 	return false
@@ -117,11 +187,21 @@ func (c *CustomLogger) IsPanicEnabled() bool {
 	return true
 }
 
+// IsLevelEnabled 检查指定级别是否启用
+func (c *CustomLogger) IsLevelEnabled(level lclogface.LogLevel) bool {
+	return level >= lclogface.InfoLevel
+}
+
 // Sync 同步日志
 func (c *CustomLogger) Sync() error {
 	return nil
 }
 
+// Close 关闭日志
+func (c *CustomLogger) Close() error {
+	return nil
+}
+
 // CustomLoggerProvider 自定义日志提供者
 type CustomLoggerProvider struct{}
 
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// queryError 实现了LogFielder的错误
+type queryError struct {
+	sql string
+	err error
+}
+
+// Error 实现error
+func (e *queryError) Error() string { return "query failed: " + e.err.Error() }
+
+// Unwrap 返回被包装的错误
+func (e *queryError) Unwrap() error { return e.err }
+
+// LogFields 实现LogFielder
+func (e *queryError) LogFields() []lclogface.Field {
+	return []lclogface.Field{lclogface.String("sql", e.sql)}
+}
+
+// TestErrorFields 测试错误展开为信息、类型、被包装的错误和自定义字段
+func TestErrorFields(t *testing.T) {
+	timeout := errors.New("timeout")
+	err := fmt.Errorf("load orders: %w", errors.Join(
+		&queryError{sql: "SELECT 1", err: timeout},
+		os.ErrNotExist,
+	))
+
+	path := filepath.Join(t.TempDir(), "error.log")
+	logger := lclogface.GetLoggerWithProvider("error", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+	)
+	logger.WithError(err).Error("加载失败")
+	logger.WithField("cause", timeout).Warn("原始错误")
+
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["error"] != err.Error() {
+		t.Errorf("Expected error message, got %v", entry["error"])
+	}
+	if entry["error_type"] != "*fmt.wrapError" {
+		t.Errorf("Expected error type, got %v", entry["error_type"])
+	}
+	causes := []interface{}{
+		"query failed: timeout\nfile does not exist",
+		"query failed: timeout",
+		"timeout",
+		"file does not exist",
+	}
+	if !reflect.DeepEqual(entry["error_causes"], causes) {
+		t.Errorf("Expected causes %v, got %v", causes, entry["error_causes"])
+	}
+	if entry["sql"] != "SELECT 1" {
+		t.Errorf("Expected LogFields to be merged, got %v", entry["sql"])
+	}
+
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["cause"] != "timeout" {
+		t.Errorf("Expected error values of Any fields to be rendered, got %v", entry["cause"])
+	}
+}
+
+// TestErrorFieldsText 测试文本格式的错误字段
+func TestErrorFieldsText(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "error.log")
+	logger := lclogface.GetLoggerWithProvider("error", "console", lclogface.WithOutputPath(path))
+	logger.Error("失败", lclogface.Err(errors.New("boom")), lclogface.Err(nil))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(string(data)), "失败 error=boom error_type=*errors.errorString error=<nil>") {
+		t.Errorf("Unexpected output %s", data)
+	}
+
+	if fields := lclogface.ErrorFields("err", nil); len(fields) != 1 || fields[0].Any() != nil {
+		t.Errorf("Expected a single nil field for nil error, got %v", fields)
+	}
+}
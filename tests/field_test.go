@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// testUser 测试用的对象字段
+type testUser struct {
+	name string
+	age  int64
+}
+
+// MarshalLogObject 实现lclogface.ObjectMarshaler
+func (u testUser) MarshalLogObject(enc lclogface.ObjectEncoder) error {
+	enc.AddString("name", u.name)
+	enc.AddInt64("age", u.age)
+	return nil
+}
+
+// testTags 测试用的数组字段
+type testTags []string
+
+// MarshalLogArray 实现lclogface.ArrayMarshaler
+func (t testTags) MarshalLogArray(enc lclogface.ArrayEncoder) error {
+	for _, tag := range t {
+		enc.AppendString(tag)
+	}
+	return nil
+}
+
+// TestTypedFields 测试类型化字段的值
+func TestTypedFields(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		field    lclogface.Field
+		expected interface{}
+	}{
+		{lclogface.String("s", "v"), "v"},
+		{lclogface.Int("i", 3), int64(3)},
+		{lclogface.Int64("i64", -7), int64(-7)},
+		{lclogface.Float64("f", 1.5), 1.5},
+		{lclogface.Bool("b", true), true},
+		{lclogface.Bool("b", false), false},
+		{lclogface.Duration("d", time.Second), time.Second},
+		{lclogface.Err(errors.New("boom")), "boom"},
+		{lclogface.Err(nil), nil},
+		{lclogface.Stringer("level", lclogface.WarnLevel), "WARN"},
+		{lclogface.Any("any", 42), 42},
+	}
+	for _, tc := range testCases {
+		if got := tc.field.Any(); got != tc.expected {
+			t.Errorf("Expected %s to be %v (%T), got %v (%T)", tc.field.Key, tc.expected, tc.expected, got, got)
+		}
+	}
+
+	if got := lclogface.Time("t", now).Any().(time.Time); !got.Equal(now) || got.Location() != time.UTC {
+		t.Errorf("Expected time %v, got %v", now, got)
+	}
+	if key := lclogface.Err(errors.New("boom")).Key; key != "error" {
+		t.Errorf("Expected Err key to be error, got %s", key)
+	}
+}
+
+// TestTypedFieldsNoAlloc 测试基本类型字段的构造不分配内存
+func TestTypedFieldsNoAlloc(t *testing.T) {
+	err := errors.New("boom")
+	now := time.Now()
+	allocs := testing.AllocsPerRun(100, func() {
+		fields := [...]lclogface.Field{
+			lclogface.String("s", "v"),
+			lclogface.Int64("i", 1),
+			lclogface.Duration("d", time.Second),
+			lclogface.Bool("b", true),
+			lclogface.Time("t", now),
+			lclogface.Err(err),
+			lclogface.Stringer("level", lclogface.InfoLevel),
+		}
+		_ = fields
+	})
+	if allocs != 0 {
+		t.Errorf("Expected typed field constructors not to allocate, got %v allocs", allocs)
+	}
+}
+
+// TestTypedFieldsOutput 测试控制台日志输出类型化字段
+func TestTypedFieldsOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fields.log")
+	logger := lclogface.GetLoggerWithProvider("fields", "console",
+		lclogface.WithOutputPath(path), lclogface.WithFormat("json"))
+	logger.Info("typed",
+		lclogface.Int64("count", 3),
+		lclogface.Err(errors.New("boom")),
+		lclogface.Object("user", testUser{name: "alice", age: 30}),
+		lclogface.Array("tags", testTags{"a", "b"}),
+	)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	for _, expected := range []string{`"count":3`, `"error":"boom"`, `"user":{"age":30,"name":"alice"}`, `"tags":["a","b"]`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %s in output, got %s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+//go:build gin_provider
+
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	ginadapter "github.com/LandcLi/landc-logface/adapter/gin"
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestGinRequestLogger 测试gin中间件为请求创建的日志实例
+func TestGinRequestLogger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	path := filepath.Join(t.TempDir(), "gin.log")
+	logger := lclogface.GetLoggerWithProvider("gin", "console", lclogface.WithOutputPath(path))
+
+	r := gin.New()
+	ginadapter.UseWithGin(r, logger)
+	r.GET("/orders", func(c *gin.Context) {
+		ginadapter.RequestLogger(c).Info("处理订单")
+		lclogface.FromContext(c.Request.Context()).Info("服务层")
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders?id=1", nil)
+	req.Header.Set("X-Trace-ID", "trace-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %s", len(lines), data)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "trace_id=trace-1 method=GET uri=/orders?id=1") {
+			t.Errorf("Expected request fields in %s", line)
+		}
+	}
+	if !strings.Contains(lines[2], "status=200") {
+		t.Errorf("Expected access log with status, got %s", lines[2])
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestKeyValues 测试Infow等方法将交替出现的键和值转换为字段
+func TestKeyValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kv.log")
+	logger := lclogface.GetLoggerWithProvider("kv", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+	)
+	logger.WithField("app", "orders").Infow("创建订单",
+		"id", "o-1",
+		"count", 3,
+		"elapsed", 1500*time.Millisecond,
+		lclogface.Bool("paid", true),
+		"err", errors.New("boom"),
+	)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(readLines(t, path)[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]interface{}{
+		"msg":      "创建订单",
+		"app":      "orders",
+		"id":       "o-1",
+		"count":    float64(3),
+		"paid":     true,
+		"err":      "boom",
+		"err_type": "*errors.errorString",
+	} {
+		if entry[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, entry[key])
+		}
+	}
+}
+
+// TestKeyValuesInvalid 测试缺少值的键和不是字符串的键以BadKey输出并交给错误处理函数
+func TestKeyValuesInvalid(t *testing.T) {
+	var reported error
+	lclogface.SetErrorHandler(func(err error) { reported = err })
+	defer lclogface.SetErrorHandler(nil)
+
+	path := filepath.Join(t.TempDir(), "kv.log")
+	logger := lclogface.GetLoggerWithProvider("kv", "console", lclogface.WithOutputPath(path))
+	logger.Warnw("非法键值对", 42, "ok", true, "dangling")
+	logger.Debugw("过滤", 42)
+
+	lines := readLines(t, path)
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 line, got %d", len(lines))
+	}
+	if !strings.HasSuffix(lines[0], "非法键值对 !BADKEY=42 ok=true !BADKEY=dangling") {
+		t.Errorf("Unexpected output %s", lines[0])
+	}
+	if !errors.Is(reported, lclogface.ErrInvalidKeyValues) {
+		t.Fatalf("Expected ErrInvalidKeyValues, got %v", reported)
+	}
+	if !strings.Contains(reported.Error(), `key "dangling" has no value`) || !strings.Contains(reported.Error(), "key 42 of type int is not a string") {
+		t.Errorf("Expected both problems to be reported, got %v", reported)
+	}
+}
+
+// TestKeyValuesGlobal 测试全局的Infow和Logw
+func TestKeyValuesGlobal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kv.log")
+	previous := lclogface.GetLogger()
+	lclogface.SetGlobalLogger(lclogface.GetLoggerWithProvider("global", "console", lclogface.WithOutputPath(path)))
+	defer lclogface.SetGlobalLogger(previous)
+
+	lclogface.Infow("全局", "user", "u-1")
+	lclogface.Logw(noticeLevel, "自定义级别", "count", 2)
+
+	lines := readLines(t, path)
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "全局 user=u-1") || !strings.Contains(lines[1], "[NOTICE]") || !strings.HasSuffix(lines[1], "count=2") {
+		t.Errorf("Unexpected output %v", lines)
+	}
+}
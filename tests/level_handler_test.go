@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// levelHandlerResponse 日志级别接口的响应
+type levelHandlerResponse struct {
+	Loggers  []lclogface.LoggerInfo `json:"loggers"`
+	RevertAt *time.Time             `json:"revertAt"`
+	Error    string                 `json:"error"`
+}
+
+// serveLevel 调用日志级别接口并解析响应
+func serveLevel(t *testing.T, method string, target string, body string) (int, levelHandlerResponse) {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	lclogface.LevelHandler().ServeHTTP(rec, req)
+
+	var resp levelHandlerResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("解析响应失败: %v", err)
+	}
+	return rec.Code, resp
+}
+
+// TestLevelHandlerList 测试列出日志实例及其级别
+func TestLevelHandlerList(t *testing.T) {
+	lclogface.GetLoggerWithProvider("admin-list", "console", lclogface.WithLevel(lclogface.WarnLevel))
+
+	code, resp := serveLevel(t, http.MethodGet, "/", "")
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", code)
+	}
+	found := false
+	for _, info := range resp.Loggers {
+		if info.Name == "admin-list" {
+			found = info.Level == lclogface.WarnLevel && info.Provider == "console"
+		}
+	}
+	if !found {
+		t.Errorf("Expected admin-list with warn level in %+v", resp.Loggers)
+	}
+
+	if code, _ := serveLevel(t, http.MethodGet, "/?name=admin-missing", ""); code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown logger, got %d", code)
+	}
+}
+
+// TestLevelHandlerSetLevel 测试修改指定日志实例的级别
+func TestLevelHandlerSetLevel(t *testing.T) {
+	log := lclogface.GetLoggerWithProvider("admin-set", "std")
+	child := log.WithField("k", "v")
+
+	code, resp := serveLevel(t, http.MethodPut, "/?name=admin-set&level=debug", "")
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", code, resp.Error)
+	}
+	if !child.IsDebugEnabled() || len(resp.Loggers) != 1 || resp.Loggers[0].Level != lclogface.DebugLevel {
+		t.Errorf("Expected debug level, got %+v", resp.Loggers)
+	}
+
+	code, _ = serveLevel(t, http.MethodPut, "/", `{"name": "admin-set", "level": "error"}`)
+	if code != http.StatusOK || log.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected JSON body to set error level, got %d %v", code, log.GetLevel())
+	}
+
+	for _, level := range []string{"loud", "7"} {
+		if code, _ := serveLevel(t, http.MethodPut, "/?name=admin-set&level="+level, ""); code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for invalid level %q, got %d", level, code)
+		}
+	}
+	if code, _ := serveLevel(t, http.MethodDelete, "/", ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405, got %d", code)
+	}
+}
+
+// TestLevelHandlerTTL 测试临时修改级别到期后恢复
+func TestLevelHandlerTTL(t *testing.T) {
+	log := lclogface.GetLoggerWithProvider("admin-ttl", "console", lclogface.WithLevel(lclogface.WarnLevel))
+
+	code, resp := serveLevel(t, http.MethodPut, "/?name=admin-ttl&level=debug&ttl=50ms", "")
+	if code != http.StatusOK || resp.RevertAt == nil {
+		t.Fatalf("Expected revertAt in response, got %d %+v", code, resp)
+	}
+	// 到期前再次修改，仍然恢复到最初的级别
+	serveLevel(t, http.MethodPut, "/?name=admin-ttl&level=info&ttl=50ms", "")
+	if log.GetLevel() != lclogface.InfoLevel {
+		t.Fatalf("Expected info level, got %v", log.GetLevel())
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for log.GetLevel() != lclogface.WarnLevel {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected level to revert to warn, got %v", log.GetLevel())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// 被取代的修改不会再次恢复
+	time.Sleep(100 * time.Millisecond)
+	if log.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected level to stay warn, got %v", log.GetLevel())
+	}
+}
+
+// TestLevelHandlerNamed 测试通过Named创建的日志实例也可以按名称查看和修改
+func TestLevelHandlerNamed(t *testing.T) {
+	log := lclogface.GetLoggerWithProvider("admin-named", "console")
+	pool := log.Named("pool")
+	defer lclogface.UnsetNamedLevel("admin-named.pool")
+
+	code, resp := serveLevel(t, http.MethodPut, "/?name=admin-named.pool&level=debug", "")
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", code, resp.Error)
+	}
+	if !pool.IsDebugEnabled() || log.IsDebugEnabled() {
+		t.Error("Expected only the named child to use debug level")
+	}
+
+	code, resp = serveLevel(t, http.MethodGet, "/?name=admin-named.pool", "")
+	if code != http.StatusOK || len(resp.Loggers) != 1 || resp.Loggers[0].Level != lclogface.DebugLevel {
+		t.Errorf("Expected the named child with debug level, got %d %+v", code, resp.Loggers)
+	}
+}
+
+// TestLevelHandlerGlobalTTL 测试全局临时修改到期后不会覆盖之后按名称做的修改
+func TestLevelHandlerGlobalTTL(t *testing.T) {
+	other := lclogface.GetLoggerWithProvider("admin-global-other", "console", lclogface.WithLevel(lclogface.InfoLevel))
+	log := lclogface.GetLoggerWithProvider("admin-global", "console", lclogface.WithLevel(lclogface.WarnLevel))
+	defer lclogface.UnsetNamedLevel("admin-global")
+
+	if code, resp := serveLevel(t, http.MethodPut, "/?level=debug&ttl=50ms", ""); code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", code, resp.Error)
+	}
+	serveLevel(t, http.MethodPut, "/?name=admin-global&level=error", "")
+
+	deadline := time.Now().Add(2 * time.Second)
+	for other.GetLevel() != lclogface.InfoLevel {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the global override to revert, got %v", other.GetLevel())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if log.GetLevel() != lclogface.ErrorLevel {
+		t.Errorf("Expected the later per-name change to be kept, got %v", log.GetLevel())
+	}
+}
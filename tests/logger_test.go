@@ -241,6 +241,7 @@ func TestLogLevelString(t *testing.T) {
 		level    lclogface.LogLevel
 		expected string
 	}{
+		{lclogface.TraceLevel, "TRACE"},
 		{lclogface.DebugLevel, "DEBUG"},
 		{lclogface.InfoLevel, "INFO"},
 		{lclogface.WarnLevel, "WARN"},
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+const loggingDocument = `
+default:
+  level: warn
+  format: json
+  maxLogFiles: 3
+loggers:
+  - name: payments
+    provider: std
+    level: debug
+  - name: audit
+    format: text
+`
+
+// TestLoadLoggingConfig 测试加载多日志实例配置文档
+func TestLoadLoggingConfig(t *testing.T) {
+	config, err := lclogface.LoadLoggingConfig(strings.NewReader(loggingDocument), lclogface.ConfigFormatYAML)
+	if err != nil {
+		t.Fatalf("加载配置文档失败: %v", err)
+	}
+
+	if len(config.Loggers) != 2 {
+		t.Fatalf("Expected 2 loggers, got %d", len(config.Loggers))
+	}
+
+	payments := config.Lookup("payments")
+	if payments.Provider != "std" || payments.Level != lclogface.DebugLevel {
+		t.Errorf("Unexpected payments definition: %+v", payments)
+	}
+	// 未声明的项继承默认配置
+	if payments.Format != "json" || payments.MaxLogFiles != 3 {
+		t.Errorf("Expected payments to inherit defaults, got format=%s maxLogFiles=%d", payments.Format, payments.MaxLogFiles)
+	}
+
+	audit := config.Lookup("audit")
+	if audit.Format != "text" || audit.Level != lclogface.WarnLevel {
+		t.Errorf("Unexpected audit definition: %+v", audit)
+	}
+
+	other := config.Lookup("other")
+	if other.Name != "other" || other.Level != lclogface.WarnLevel {
+		t.Errorf("Expected default definition for unknown name, got %+v", other)
+	}
+}
+
+// TestLoadLoggingConfigSingle 测试没有default和loggers时整个文档作为默认配置
+func TestLoadLoggingConfigSingle(t *testing.T) {
+	config, err := lclogface.LoadLoggingConfig(strings.NewReader(`{"level": "error"}`), lclogface.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Default.Level != lclogface.ErrorLevel || len(config.Loggers) != 0 {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+}
+
+// TestLoadLoggingConfigErrors 测试非法的配置文档
+func TestLoadLoggingConfigErrors(t *testing.T) {
+	invalid := []string{
+		`{"loggers": [{"level": "debug"}]}`,
+		`{"loggers": [{"name": "a"}, {"name": "a"}]}`,
+		`{"default": {"level": "loud"}}`,
+	}
+
+	for _, content := range invalid {
+		if _, err := lclogface.LoadLoggingConfig(strings.NewReader(content), lclogface.ConfigFormatJSON); err == nil {
+			t.Errorf("Expected error for %s", content)
+		}
+	}
+}
+
+// TestConfigureNamedLoggers 测试日志工厂按名称使用配置文档
+func TestConfigureNamedLoggers(t *testing.T) {
+	config, err := lclogface.LoadLoggingConfig(strings.NewReader(loggingDocument), lclogface.ConfigFormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lclogface.Configure(config)
+	defer lclogface.Configure(nil)
+
+	payments := lclogface.GetLoggerWithName("payments")
+	if !payments.IsDebugEnabled() {
+		t.Error("Expected payments logger to use its own debug level")
+	}
+
+	other := lclogface.GetLoggerWithName("other")
+	if other.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected default warn level, got %v", other.GetLevel())
+	}
+}
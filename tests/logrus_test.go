@@ -3,6 +3,12 @@
 package tests
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/LandcLi/landc-logface/lclogface"
@@ -39,3 +45,196 @@ func TestLogrusWithOptions(t *testing.T) {
 
 	logger.Info("Logrus带选项测试")
 }
+
+// TestLogrusSetLevel 测试修改级别对logrus底层和派生实例生效
+func TestLogrusSetLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus.log")
+	logger := lclogface.GetLoggerWithProvider("test-level", "logrus",
+		lclogface.WithLevel(lclogface.ErrorLevel),
+		lclogface.WithOutputPath(path),
+	)
+	child := logger.WithField("child", true)
+
+	child.Debug("before")
+	logger.SetLevel(lclogface.DebugLevel)
+	child.Debug("after")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "before") || !strings.Contains(string(data), "after") {
+		t.Errorf("Expected only the message after SetLevel, got %s", data)
+	}
+}
+
+// TestLogrusNamed 测试logrus输出层级名称
+func TestLogrusNamed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-named.log")
+	logger := lclogface.GetLoggerWithProvider("test-named", "logrus", lclogface.WithOutputPath(path))
+	logger.Named("db").Info("named")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `logger=test-named.db`) {
+		t.Errorf("Expected logger name in output, got %s", data)
+	}
+}
+
+// TestLogrusTraceAndCustomLevel 测试logrus输出跟踪级别和自定义级别
+func TestLogrusTraceAndCustomLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-levels.log")
+	logger := lclogface.GetLoggerWithProvider("test-levels", "logrus",
+		lclogface.WithOutputPath(path), lclogface.WithFormat("json"), lclogface.WithLevel(lclogface.TraceLevel))
+	logger.Trace("trace message")
+	logger.Log(noticeLevel, "notice message")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	if !strings.Contains(output, `"level":"trace"`) {
+		t.Errorf("Expected trace level in output, got %s", output)
+	}
+	if !strings.Contains(output, `"custom_level":"notice"`) || !strings.Contains(output, `"level":"info"`) {
+		t.Errorf("Expected notice logged at info with custom_level field, got %s", output)
+	}
+}
+
+// TestLogrusContextFields 测试logrus输出从上下文提取的字段
+func TestLogrusContextFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-context.log")
+	logger := lclogface.GetLoggerWithProvider("test-context", "logrus",
+		lclogface.WithOutputPath(path), lclogface.WithFormat("json"))
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	logger.WithContext(ctx).Info("context")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"trace_id":"trace-1"`) {
+		t.Errorf("Expected trace_id in output, got %s", data)
+	}
+}
+
+// TestLogrusCaller 测试logrus输出应用代码的调用位置而不是适配器内部
+func TestLogrusCaller(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-caller.log")
+	logger := lclogface.GetLoggerWithProvider("test-caller", "logrus",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+		lclogface.WithCaller(true),
+	)
+	expected := callerLine()
+	logger.Info("caller")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"file":"`+expected+`"`) || !strings.Contains(string(data), `tests.TestLogrusCaller"`) {
+		t.Errorf("Expected caller %s in output, got %s", expected, data)
+	}
+}
+
+// TestLogrusStackTrace 测试logrus文本格式以缩进块输出堆栈
+func TestLogrusStackTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-stack.log")
+	logger := lclogface.GetLoggerWithProvider("test-stack", "logrus",
+		lclogface.WithOutputPath(path),
+		lclogface.WithStackTrace(lclogface.ErrorLevel),
+	)
+	logger.Error("error")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) < 3 || strings.Contains(lines[0], "stacktrace") {
+		t.Fatalf("Expected stack block below the entry, got %s", data)
+	}
+	if lines[1] != "\tgithub.com/LandcLi/landc-logface/tests.TestLogrusStackTrace" {
+		t.Errorf("Expected stack to start at the test function, got %q", lines[1])
+	}
+}
+
+// TestLogrusErrorFields 测试logrus展开错误字段
+func TestLogrusErrorFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-error.log")
+	logger := lclogface.GetLoggerWithProvider("test-error", "logrus",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+	)
+	err := fmt.Errorf("load: %w", &queryError{sql: "SELECT 1", err: errors.New("timeout")})
+	logger.WithError(err).Error("failed")
+
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	for _, expected := range []string{
+		`"error":"load: query failed: timeout"`,
+		`"error_type":"*fmt.wrapError"`,
+		`"error_causes":["query failed: timeout","timeout"]`,
+		`"sql":"SELECT 1"`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected %s in output, got %s", expected, data)
+		}
+	}
+}
+
+// TestLogrusFormattedAndKeyValues 测试logrus的格式化方法和Infow保留WithField添加的字段
+func TestLogrusFormattedAndKeyValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logrus-kv.log")
+	logger := lclogface.GetLoggerWithProvider("test-kv", "logrus",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+	).WithField("app", "orders")
+	logger.Infof("created %d", 3)
+	logger.Infow("created", "count", 3)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, `"app":"orders"`) {
+			t.Errorf("Expected WithField fields to be kept, got %s", line)
+		}
+	}
+	if !strings.Contains(lines[1], `"count":3`) {
+		t.Errorf("Expected key-value fields, got %s", lines[1])
+	}
+}
+
+// TestLogrusOutputs 测试logrus同时写入多个输出
+func TestLogrusOutputs(t *testing.T) {
+	testProviderOutputs(t, "logrus")
+}
+
+// TestLogrusAsync 测试logrus异步写入时Sync等待队列中的日志全部写入
+func TestLogrusAsync(t *testing.T) {
+	testProviderAsync(t, "logrus")
+}
+
+// TestLogrusClose 测试logrus关闭后写完缓冲的日志并丢弃之后的日志
+func TestLogrusClose(t *testing.T) {
+	testProviderClose(t, "logrus")
+}
+
+// TestLogrusFatal 测试logrus在Fatal退出程序前刷新输出
+func TestLogrusFatal(t *testing.T) {
+	testProviderFatal(t, "logrus")
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestNamedLogger 测试Named创建的层级名称
+func TestNamedLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "named.log")
+	app := lclogface.GetLoggerWithProvider("named-app", "console", lclogface.WithOutputPath(path))
+	pool := app.Named("db").Named("pool")
+
+	pool.Info("连接池已满")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "[named-app.db.pool]") {
+		t.Errorf("Expected hierarchical name in output, got %s", data)
+	}
+}
+
+// TestNamedLoggerLevel 测试子实例继承父实例的级别，并且可以单独修改
+func TestNamedLoggerLevel(t *testing.T) {
+	app := lclogface.GetLoggerWithProvider("named-level", "std", lclogface.WithLevel(lclogface.WarnLevel))
+	db := app.Named("db")
+	if db.GetLevel() != lclogface.WarnLevel {
+		t.Fatalf("Expected child to inherit warn level, got %v", db.GetLevel())
+	}
+
+	db.SetLevel(lclogface.DebugLevel)
+	if app.GetLevel() != lclogface.WarnLevel {
+		t.Error("Expected parent level to be unaffected by child")
+	}
+	if !db.WithField("k", "v").IsDebugEnabled() {
+		t.Error("Expected fields of the child to share its level")
+	}
+}
+
+// TestSetNamedLevel 测试为祖先设置的级别应用到所有后代
+func TestSetNamedLevel(t *testing.T) {
+	app := lclogface.GetLoggerWithProvider("hier", "console")
+	db := app.Named("db")
+	pool := db.Named("pool")
+	cache := app.Named("cache")
+	defer lclogface.UnsetNamedLevel("hier.db")
+	defer lclogface.UnsetNamedLevel("hier.db.pool")
+
+	lclogface.SetNamedLevel("hier.db", lclogface.DebugLevel)
+	if !db.IsDebugEnabled() || !pool.IsDebugEnabled() {
+		t.Error("Expected hier.db and its descendants to use debug level")
+	}
+	if cache.IsDebugEnabled() || app.IsDebugEnabled() {
+		t.Error("Expected siblings and ancestors to keep their level")
+	}
+
+	// 更近的设置优先
+	lclogface.SetNamedLevel("hier.db.pool", lclogface.ErrorLevel)
+	lclogface.SetNamedLevel("hier.db", lclogface.WarnLevel)
+	if pool.GetLevel() != lclogface.ErrorLevel || db.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected pool=error db=warn, got pool=%v db=%v", pool.GetLevel(), db.GetLevel())
+	}
+
+	// 之后创建的后代同样生效
+	if replica := db.Named("replica"); replica.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected new descendant to use warn level, got %v", replica.GetLevel())
+	}
+
+	lclogface.UnsetNamedLevel("hier.db.pool")
+	if pool.GetLevel() != lclogface.WarnLevel {
+		t.Errorf("Expected pool to fall back to hier.db level, got %v", pool.GetLevel())
+	}
+	if level, ok := lclogface.NamedLevel("hier.db.pool"); !ok || level != lclogface.WarnLevel {
+		t.Errorf("Expected inherited warn level, got %v %v", level, ok)
+	}
+}
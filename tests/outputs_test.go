@@ -0,0 +1,342 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestOutputs 测试同时写入多个输出，每个输出有自己的格式和最低级别
+func TestOutputs(t *testing.T) {
+	dir := t.TempDir()
+	appPath := filepath.Join(dir, "app.log")
+	errorPath := filepath.Join(dir, "error.log")
+	logger := lclogface.GetLoggerWithProvider("outputs", "console",
+		lclogface.WithLevel(lclogface.DebugLevel),
+		lclogface.WithOutputs(
+			lclogface.NewOutputConfig(appPath).WithFormat("json"),
+			lclogface.NewOutputConfig(errorPath).WithLevel(lclogface.ErrorLevel),
+		),
+	)
+	logger.Trace("被日志实例过滤")
+	logger.Debug("调试")
+	logger.WithField("order", "o-1").Error("失败")
+
+	appLines := readLines(t, appPath)
+	if len(appLines) != 2 {
+		t.Fatalf("Expected 2 lines in app.log, got %v", appLines)
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(appLines[1]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["msg"] != "失败" || entry["order"] != "o-1" {
+		t.Errorf("Unexpected json entry %v", entry)
+	}
+
+	errorLines := readLines(t, errorPath)
+	if len(errorLines) != 1 || !strings.Contains(errorLines[0], "[ERROR] [outputs] 失败 order=o-1") {
+		t.Errorf("Expected only the error entry as text in error.log, got %v", errorLines)
+	}
+}
+
+// TestOutputsReconfigure 测试重新配置时替换所有输出
+func TestOutputsReconfigure(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.log")
+	second := filepath.Join(dir, "second.log")
+	logger := lclogface.GetLoggerWithProvider("outputs", "std",
+		lclogface.WithOutputs(lclogface.NewOutputConfig(first), lclogface.NewOutputConfig(second)),
+	)
+	logger.Info("两个输出")
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath(second)); err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("一个输出")
+
+	if lines := readLines(t, first); len(lines) != 1 {
+		t.Errorf("Expected first.log to stop receiving entries, got %v", lines)
+	}
+	if lines := readLines(t, second); len(lines) != 2 {
+		t.Errorf("Expected second.log to keep receiving entries, got %v", lines)
+	}
+}
+
+// TestOutputsLogConfig 测试在LogConfig中配置多个输出
+func TestOutputsLogConfig(t *testing.T) {
+	dir := t.TempDir()
+	data := `{
+		"provider": "console",
+		"name": "outputs-config",
+		"level": "info",
+		"outputs": [
+			{"path": "stdout"},
+			{"path": "` + filepath.ToSlash(filepath.Join(dir, "app.log")) + `", "format": "json", "maxLogSize": "50MB", "maxLogAge": "3d"},
+			{"path": "` + filepath.ToSlash(filepath.Join(dir, "error.log")) + `", "level": "error", "compressLogs": true}
+		]
+	}`
+	config, err := lclogface.LoadConfig(strings.NewReader(data), lclogface.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Outputs) != 3 {
+		t.Fatalf("Expected 3 outputs, got %d", len(config.Outputs))
+	}
+	if config.Outputs[1].MaxLogSize != 50 || config.Outputs[1].MaxLogAge != 72*time.Hour {
+		t.Errorf("Expected readable sizes and durations, got %+v", config.Outputs[1])
+	}
+	if level := config.Outputs[2].Level; level == nil || *level != lclogface.ErrorLevel || config.Outputs[2].CompressLogs == nil {
+		t.Errorf("Expected error level and compression, got %+v", config.Outputs[2])
+	}
+
+	clone := config.Clone()
+	*clone.Outputs[2].Level = lclogface.WarnLevel
+	if *config.Outputs[2].Level != lclogface.ErrorLevel {
+		t.Error("Expected Clone to copy outputs")
+	}
+
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded lclogface.LogConfig
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Outputs, config.Outputs) {
+		t.Errorf("Expected outputs to round trip, got %+v", decoded.Outputs)
+	}
+
+	logger := lclogface.GetLoggerWithLogConfig(config)
+	logger.Warn("警告")
+	logger.Error("错误")
+	if lines := readLines(t, filepath.Join(dir, "app.log")); len(lines) != 2 {
+		t.Errorf("Expected 2 lines in app.log, got %v", lines)
+	}
+	if lines := readLines(t, filepath.Join(dir, "error.log")); len(lines) != 1 {
+		t.Errorf("Expected 1 line in error.log, got %v", lines)
+	}
+}
+
+// TestOutputsValidation 测试多输出配置的严格验证和配置map的转换
+func TestOutputsValidation(t *testing.T) {
+	level := lclogface.LogLevel(12345)
+	config := lclogface.NewLogConfig().WithOutputs(
+		lclogface.OutputConfig{Format: "xml"},
+		lclogface.OutputConfig{Path: "app.log", Level: &level, MaxLogFiles: -1},
+	)
+	err := config.ValidateStrict()
+	if !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+	}
+	for _, expected := range []string{"outputs[0].path", "outputs[0].format", "outputs[1].level", "outputs[1].maxLogFiles"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to mention %s, got %v", expected, err)
+		}
+	}
+
+	var configMap map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"provider": "console", "outputs": [{"path": "stdout", "format": "xml"}]}`), &configMap); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lclogface.BuildLoggerWithMap("outputs-map", configMap); err == nil || !strings.Contains(err.Error(), "outputs") {
+		t.Errorf("Expected invalid outputs in the config map to be reported, got %v", err)
+	}
+}
+
+// testProviderOutputs 测试提供者同时写入JSON和文本格式的输出，且文本输出只写入警告及以上级别
+func testProviderOutputs(t *testing.T, provider string) {
+	t.Helper()
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "app.json")
+	textPath := filepath.Join(dir, "warn.log")
+	logger := lclogface.GetLoggerWithProvider("test-"+provider, provider,
+		lclogface.WithOutputs(
+			lclogface.NewOutputConfig(jsonPath).WithFormat("json"),
+			lclogface.NewOutputConfig(textPath).WithFormat("text").WithLevel(lclogface.WarnLevel),
+		),
+	)
+	logger.Info("信息")
+	logger.Error("错误")
+
+	jsonLines := readLines(t, jsonPath)
+	if len(jsonLines) != 2 {
+		t.Fatalf("Expected 2 lines in the json output, got %v", jsonLines)
+	}
+	for _, line := range jsonLines {
+		if !json.Valid([]byte(line)) {
+			t.Errorf("Expected json entry, got %q", line)
+		}
+	}
+	textLines := readLines(t, textPath)
+	if len(textLines) != 1 || json.Valid([]byte(textLines[0])) || !strings.Contains(textLines[0], "错误") {
+		t.Errorf("Expected only the error entry as text, got %v", textLines)
+	}
+}
+
+// TestSharedOutputFile 测试写入同一文件的日志实例共享轮转，轮转后所有日志实例都写入新文件
+func TestSharedOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "shared.log")
+	a := lclogface.GetLoggerWithProvider("shared-a", "console", lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(1))
+	b := lclogface.GetLoggerWithProvider("shared-b", "std", lclogface.WithOutputPath(filepath.Join(dir, ".", "shared.log")), lclogface.WithMaxLogSize(1))
+
+	large := strings.Repeat("x", 600*1024)
+	a.Info(large)
+	b.Info("b1")
+	a.Info(large)
+	b.Info("b2")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 || !strings.HasSuffix(lines[1], "b2") {
+		t.Errorf("Expected the current file to hold the rotated entries, got %d lines", len(lines))
+	}
+	backups, err := filepath.Glob(filepath.Join(dir, "shared-*.log"))
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected a single rotation, got %v", backups)
+	}
+
+	// 关闭一个日志实例不影响其他日志实例继续写入同一文件
+	if err := a.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Fatal(err)
+	}
+	b.Info("b3")
+	if lines := readLines(t, path); !strings.HasSuffix(lines[len(lines)-1], "b3") {
+		t.Errorf("Expected the shared file to stay open, got %v", lines[len(lines)-1])
+	}
+	if err := b.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Errorf("Expected the shared file to be closed, got %v", err)
+	}
+}
+
+// TestSharedOutputFileConflict 测试以不同轮转配置打开同一文件时报告冲突并保留最先打开时的配置
+func TestSharedOutputFileConflict(t *testing.T) {
+	var reported []error
+	lclogface.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	defer lclogface.SetErrorHandler(nil)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "conflict.log")
+	a := lclogface.GetLoggerWithProvider("conflict-a", "console", lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(1))
+	b := lclogface.GetLoggerWithProvider("conflict-b", "std", lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(10))
+	if len(reported) != 1 || !strings.Contains(reported[0].Error(), path) {
+		t.Fatalf("Expected the conflicting settings to be reported, got %v", reported)
+	}
+
+	large := strings.Repeat("x", 600*1024)
+	b.Info(large)
+	b.Info(large)
+	if backups, _ := filepath.Glob(filepath.Join(dir, "conflict-*.log")); len(backups) != 1 {
+		t.Errorf("Expected the first settings to be kept, got backups %v", backups)
+	}
+
+	// 重新配置不会替换共享文件的lumberjack，不会遗留它的清理协程
+	before := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		if err := b.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(int64(i+2))); err != nil {
+			t.Fatal(err)
+		}
+		b.Info("reconfigured")
+	}
+	if after := runtime.NumGoroutine(); after > before+5 {
+		t.Errorf("Expected reconfiguring not to leak goroutines, got %d before and %d after", before, after)
+	}
+	a.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout"))
+	b.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout"))
+}
+
+// TestSharedOutputFileReconfigure 测试同一日志实例重新配置同一文件的轮转配置时替换原有配置，不报告冲突
+func TestSharedOutputFileReconfigure(t *testing.T) {
+	var reported []error
+	lclogface.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	defer lclogface.SetErrorHandler(nil)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "reload.log")
+	large := strings.Repeat("x", 600*1024)
+	for _, provider := range []string{"console", "std"} {
+		logger := lclogface.GetLoggerWithProvider("reload-"+provider, provider, lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(10))
+		logger.Info(large)
+		logger.Info(large)
+		if backups, _ := filepath.Glob(filepath.Join(dir, "reload-*.log")); len(backups) != 0 {
+			t.Fatalf("Expected no rotation below 10MB, got backups %v", backups)
+		}
+
+		if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath(path), lclogface.WithMaxLogSize(1)); err != nil {
+			t.Fatal(err)
+		}
+		if len(reported) != 0 {
+			t.Fatalf("Expected reconfiguring the same logger not to report a conflict, got %v", reported)
+		}
+		logger.Info(large)
+		if backups, _ := filepath.Glob(filepath.Join(dir, "reload-*.log")); len(backups) != 1 {
+			t.Errorf("Expected the new max size to rotate the file, got backups %v", backups)
+		}
+		logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout"))
+		os.Remove(path)
+		matches, _ := filepath.Glob(filepath.Join(dir, "reload-*.log"))
+		for _, backup := range matches {
+			os.Remove(backup)
+		}
+	}
+}
+
+// testProviderAsync 测试提供者异步写入时Sync等待队列中的日志全部写入
+func testProviderAsync(t *testing.T, provider string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "async.log")
+	logger := lclogface.GetLoggerWithProvider("test-"+provider, provider,
+		lclogface.WithOutputPath(path),
+		lclogface.WithAsync(8),
+	)
+	for i := 0; i < 200; i++ {
+		logger.Info(fmt.Sprintf("entry-%d", i))
+	}
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := readLines(t, path)
+	if len(lines) != 200 || !strings.Contains(lines[199], "entry-199") {
+		t.Errorf("Expected Sync to write all 200 entries in order, got %d", len(lines))
+	}
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestAsync 测试异步写入和配置
+func TestAsync(t *testing.T) {
+	testProviderAsync(t, "console")
+	testProviderAsync(t, "std")
+
+	config, err := lclogface.LoadConfig(strings.NewReader(`{"asyncQueueSize": 1024, "overflowPolicy": "drop_below_level", "overflowLevel": "error"}`), lclogface.ConfigFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.AsyncQueueSize != 1024 || config.OverflowPolicy != lclogface.OverflowDropBelowLevel || *config.OverflowLevel != lclogface.ErrorLevel {
+		t.Errorf("Unexpected async config %+v", config)
+	}
+
+	invalid := lclogface.NewLogConfig().WithAsync(-1).WithOverflowPolicy("drop_all")
+	err = invalid.ValidateStrict()
+	for _, expected := range []string{"asyncQueueSize", `overflowPolicy "drop_all"`} {
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to mention %s, got %v", expected, err)
+		}
+	}
+
+	_, err = lclogface.BuildLoggerWithMap("async-map", map[string]interface{}{"provider": "console", "overflowPolicy": "DROP_OLDEST"})
+	if err != nil {
+		t.Errorf("Expected overflow policy names to be case-insensitive, got %v", err)
+	}
+}
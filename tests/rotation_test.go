@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// waitNextSecond 等待到下一秒开始，使按秒轮转的日志写入下一个周期
+func waitNextSecond() {
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)) + 10*time.Millisecond)
+}
+
+// TestTimeRotationPattern 测试按时间模式轮转、符号链接、数量限制和压缩
+func TestTimeRotationPattern(t *testing.T) {
+	dir := t.TempDir()
+	link := filepath.Join(dir, "current.log")
+	logger := lclogface.GetLoggerWithProvider("rotation", "console",
+		lclogface.WithOutputPath(filepath.Join(dir, "app-%Y%m%d-%H%M%S.log")), // 未设置间隔时按模式中的秒轮转
+		lclogface.WithCurrentLink(link),
+		lclogface.WithMaxLogFiles(1),
+		lclogface.WithCompressLogs(true),
+	)
+	logger.Info("first")
+	waitNextSecond()
+	logger.Info("second")
+	waitNextSecond()
+	logger.Info("third")
+
+	target, err := os.Readlink(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.IsAbs(target) || !strings.HasPrefix(target, "app-") {
+		t.Errorf("Expected a relative link to the current file, got %q", target)
+	}
+	if lines := readLines(t, link); len(lines) != 1 || !strings.HasSuffix(lines[0], "third") {
+		t.Errorf("Expected the link to point at the current file, got %v", lines)
+	}
+
+	// 关闭日志实例时等待清理完成
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Fatal(err)
+	}
+	current, _ := filepath.Glob(filepath.Join(dir, "app-*.log"))
+	compressed, _ := filepath.Glob(filepath.Join(dir, "app-*.log.gz"))
+	if len(current) != 1 || filepath.Base(current[0]) != target {
+		t.Errorf("Expected only the current file to stay uncompressed, got %v", current)
+	}
+	if len(compressed) != 1 {
+		t.Errorf("Expected a single compressed file to be kept, got %v", compressed)
+	}
+}
+
+// TestTimeRotationPatternConflict 测试以不同配置打开同一时间模式路径时报告冲突，继续按最先打开时的配置轮转
+func TestTimeRotationPatternConflict(t *testing.T) {
+	var reported []error
+	lclogface.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	defer lclogface.SetErrorHandler(nil)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app-%Y%m%d-%H%M%S.log")
+	link := filepath.Join(dir, "current.log")
+	first := lclogface.GetLoggerWithProvider("rotation-first", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithCurrentLink(link),
+	)
+	second := lclogface.GetLoggerWithProvider("rotation-second", "std",
+		lclogface.WithOutputPath(path),
+		lclogface.WithCurrentLink(filepath.Join(dir, "other.log")),
+		lclogface.WithRotateInterval(time.Hour),
+	)
+	if len(reported) != 1 || !strings.Contains(reported[0].Error(), path) {
+		t.Fatalf("Expected the conflicting settings to be reported, got %v", reported)
+	}
+	if _, err := os.Lstat(filepath.Join(dir, "other.log")); !os.IsNotExist(err) {
+		t.Errorf("Expected the conflicting link not to be created, got %v", err)
+	}
+
+	second.Info("first")
+	waitNextSecond()
+	second.Info("second")
+	if files, _ := filepath.Glob(filepath.Join(dir, "app-*.log")); len(files) != 2 {
+		t.Errorf("Expected the file to keep rotating every second, got %v", files)
+	}
+	if lines := readLines(t, link); len(lines) != 1 || !strings.HasSuffix(lines[0], "second") {
+		t.Errorf("Expected the first link to follow the current file, got %v", lines)
+	}
+
+	first.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout"))
+	second.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout"))
+}
+
+// TestTimeRotationPlainPath 测试不包含时间模式的路径按时间把上一周期的文件移为备份
+func TestTimeRotationPlainPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	logger := lclogface.GetLoggerWithProvider("rotation", "std",
+		lclogface.WithOutputPath(path),
+		lclogface.WithRotateInterval(time.Second),
+	)
+	logger.Info("first")
+	waitNextSecond()
+	logger.Info("second")
+
+	if lines := readLines(t, path); len(lines) != 1 || !strings.HasSuffix(lines[0], "second") {
+		t.Errorf("Expected the current file to start with the new period, got %v", lines)
+	}
+	backups, _ := filepath.Glob(filepath.Join(dir, "app-*.log"))
+	if len(backups) != 1 {
+		t.Fatalf("Expected a single backup, got %v", backups)
+	}
+	if lines := readLines(t, backups[0]); len(lines) != 1 || !strings.HasSuffix(lines[0], "first") {
+		t.Errorf("Expected the backup to hold the previous period, got %v", lines)
+	}
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestTimeRotationConfig 测试在配置文件中设置按时间轮转
+func TestTimeRotationConfig(t *testing.T) {
+	data := `
+provider: console
+outputPath: logs/app-%Y%m%d.log
+rotateInterval: 1d
+currentLink: logs/current.log
+outputs:
+  - path: logs/error-%Y%m%d-%H.log
+    level: error
+    rotateInterval: 1h
+`
+	config, err := lclogface.LoadConfig(strings.NewReader(data), lclogface.ConfigFormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.RotateInterval != 24*time.Hour || config.CurrentLink != "logs/current.log" {
+		t.Errorf("Unexpected rotation config %v %q", config.RotateInterval, config.CurrentLink)
+	}
+	if config.Outputs[0].RotateInterval != time.Hour {
+		t.Errorf("Expected output rotation interval of 1h, got %v", config.Outputs[0].RotateInterval)
+	}
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded lclogface.LogConfig
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.RotateInterval != config.RotateInterval || decoded.Outputs[0].RotateInterval != time.Hour {
+		t.Errorf("Expected rotation intervals to round trip, got %s", encoded)
+	}
+
+	invalid := lclogface.NewLogConfig().
+		WithOutputPath("app-%Q.log").
+		WithOutputs(lclogface.NewOutputConfig("error.log").WithRotateInterval(-time.Hour))
+	err = invalid.ValidateStrict()
+	if !errors.Is(err, lclogface.ErrInvalidConfig) {
+		t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+	}
+	for _, expected := range []string{"unsupported directive %Q", "outputs[0].rotateInterval"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to mention %s, got %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// subprocessEnv 子进程中传递日志文件路径的环境变量，设置时当前测试运行在子进程中
+const subprocessEnv = "LANDC_LOGFACE_TEST_SUBPROCESS"
+
+// runSubprocess 在子进程中重新运行当前测试，用于测试会退出进程或关闭全局日志实例的场景
+// path通过环境变量传给子进程，返回子进程的退出码和输出
+func runSubprocess(t *testing.T, path string) (int, string) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], "-test.run=^"+t.Name()+"$", "-test.v")
+	cmd.Env = append(os.Environ(), subprocessEnv+"="+path)
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), string(output)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return 0, string(output)
+}
+
+// testProviderClose 测试Close写完异步队列中的日志并关闭文件，关闭后的日志被丢弃，重新配置后恢复写入
+func testProviderClose(t *testing.T, provider string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "close.log")
+	logger := lclogface.GetLoggerWithProvider("close-"+provider, provider,
+		lclogface.WithOutputPath(path),
+		lclogface.WithAsync(8),
+	)
+	for i := 0; i < 100; i++ {
+		logger.Info(fmt.Sprintf("entry-%d", i))
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if lines := readLines(t, path); len(lines) != 100 || !strings.Contains(lines[99], "entry-99") {
+		t.Fatalf("Expected Close to write all 100 entries, got %d", len(lines))
+	}
+
+	logger.WithField("derived", true).Info("after close")
+	if err := logger.Close(); err != nil {
+		t.Errorf("Expected closing twice to succeed, got %v", err)
+	}
+	if lines := readLines(t, path); len(lines) != 100 {
+		t.Errorf("Expected entries written after Close to be dropped, got %d lines", len(lines))
+	}
+
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath(path)); err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("reopened")
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if lines := readLines(t, path); len(lines) != 101 || !strings.Contains(lines[100], "reopened") {
+		t.Errorf("Expected Reconfigure to reopen the output, got %d lines", len(lines))
+	}
+}
+
+// testProviderFatal 测试Fatal在退出程序前写完异步队列中的日志
+func testProviderFatal(t *testing.T, provider string) {
+	t.Helper()
+	if path := os.Getenv(subprocessEnv); path != "" {
+		logger := lclogface.GetLoggerWithProvider("fatal-"+provider, provider,
+			lclogface.WithOutputPath(path),
+			lclogface.WithAsync(4096),
+		)
+		for i := 0; i < 1000; i++ {
+			logger.Info(fmt.Sprintf("entry-%d", i))
+		}
+		logger.Fatal("fatal")
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "fatal.log")
+	code, output := runSubprocess(t, path)
+	if code != 1 {
+		t.Fatalf("Expected Fatal to exit with code 1, got %d:\n%s", code, output)
+	}
+	lines := readLines(t, path)
+	if len(lines) != 1001 || !strings.Contains(lines[1000], "fatal") {
+		t.Errorf("Expected all 1001 entries to be written before exit, got %d", len(lines))
+	}
+}
+
+// TestClose 测试关闭日志实例
+func TestClose(t *testing.T) {
+	testProviderClose(t, "console")
+	testProviderClose(t, "std")
+}
+
+// TestFatalFlush 测试Fatal退出前刷新输出
+func TestFatalFlush(t *testing.T) {
+	t.Run("console", func(t *testing.T) {
+		testProviderFatal(t, "console")
+	})
+	t.Run("std", func(t *testing.T) {
+		testProviderFatal(t, "std")
+	})
+}
+
+// TestShutdown 测试Shutdown刷新并关闭全局日志工厂创建的所有日志实例
+func TestShutdown(t *testing.T) {
+	if path := os.Getenv(subprocessEnv); path != "" {
+		async := lclogface.GetLoggerWithProvider("shutdown-async", "console",
+			lclogface.WithOutputPath(path),
+			lclogface.WithAsync(4096),
+		)
+		sync := lclogface.GetLoggerWithProvider("shutdown-sync", "std", lclogface.WithOutputPath(path))
+		lclogface.Configure(lclogface.NewLoggingConfig().
+			WithLogger(lclogface.NewLogConfig().WithName("shutdown-named").WithOutputPath(path)))
+		named := lclogface.GetLoggerWithName("shutdown-named")
+		for i := 0; i < 1000; i++ {
+			async.Info(fmt.Sprintf("entry-%d", i))
+		}
+		sync.Info("sync entry")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := lclogface.Shutdown(ctx); err != nil {
+			t.Fatal(err)
+		}
+		async.Info("after shutdown")
+		sync.Info("after shutdown")
+		named.Info("after shutdown")
+		// 按名称获取时重新创建实例
+		lclogface.GetLoggerWithName("shutdown-named").Info("recreated")
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "shutdown.log")
+	if code, output := runSubprocess(t, path); code != 0 {
+		t.Fatalf("Subprocess failed with code %d:\n%s", code, output)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 1002 || !strings.Contains(lines[1001], "recreated") {
+		t.Errorf("Expected Shutdown to write all 1001 entries and the recreated logger to write after it, got %d", len(lines))
+	}
+	if strings.Contains(string(data), "after shutdown") {
+		t.Error("Expected entries written after Shutdown to be dropped")
+	}
+}
+
+// TestShutdownDerived 测试Shutdown关闭只剩派生实例的日志实例的输出
+func TestShutdownDerived(t *testing.T) {
+	if path := os.Getenv(subprocessEnv); path != "" {
+		derived := lclogface.GetLoggerWithProvider("shutdown-derived", "console", lclogface.WithOutputPath(path)).
+			WithField("k", "v")
+		derived.Info("before shutdown")
+		runtime.GC()
+		runtime.GC()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := lclogface.Shutdown(ctx); err != nil {
+			t.Fatal(err)
+		}
+		derived.Info("after shutdown")
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "shutdown.log")
+	if code, output := runSubprocess(t, path); code != 0 {
+		t.Fatalf("Subprocess failed with code %d:\n%s", code, output)
+	}
+	lines := readLines(t, path)
+	if len(lines) != 1 || !strings.Contains(lines[0], "before shutdown") {
+		t.Errorf("Expected entries written after Shutdown to be dropped, got %v", lines)
+	}
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestSlogHandler 测试slog的属性、分组和WithAttrs映射为字段
+func TestSlogHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slog.log")
+	logger := lclogface.GetLoggerWithProvider("slog", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+		lclogface.WithCaller(true),
+	)
+	log := slog.New(lclogface.NewSlogHandler(logger)).With("app", "orders")
+
+	expected := callerLine()
+	log.WithGroup("req").With("id", "r-1").Info("处理请求", "status", 200, slog.Group("db", "rows", 3), slog.Group("empty"))
+	log.WithGroup("unused").Warn("空分组")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["msg"] != "处理请求" || entry["level"] != "INFO" || entry["app"] != "orders" {
+		t.Errorf("Unexpected entry %v", entry)
+	}
+	req := map[string]interface{}{"id": "r-1", "status": float64(200), "db": map[string]interface{}{"rows": float64(3)}}
+	if !reflect.DeepEqual(entry["req"], req) {
+		t.Errorf("Expected nested group %v, got %v", req, entry["req"])
+	}
+	if entry["caller"] != expected {
+		t.Errorf("Expected caller %s, got %v", expected, entry["caller"])
+	}
+	if strings.Contains(lines[1], "unused") || !strings.Contains(lines[1], `"level":"WARN"`) {
+		t.Errorf("Expected empty group to be omitted, got %s", lines[1])
+	}
+}
+
+// TestSlogHandlerLevels 测试slog级别的映射和过滤
+func TestSlogHandlerLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slog.log")
+	logger := lclogface.GetLoggerWithProvider("slog", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithLevel(lclogface.InfoLevel),
+	)
+	handler := lclogface.NewSlogHandler(logger)
+	if handler.Enabled(context.Background(), slog.LevelDebug) || !handler.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("Expected slog levels to follow the logger level")
+	}
+
+	previous := slog.Default()
+	slog.SetDefault(slog.New(handler))
+	defer slog.SetDefault(previous)
+
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	slog.Debug("过滤")
+	slog.Log(ctx, slog.LevelWarn+2, "介于警告和错误之间")
+	slog.ErrorContext(ctx, "错误")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], "[WARN]") || !strings.HasSuffix(lines[0], "trace_id=trace-1") {
+		t.Errorf("Expected warn with trace_id, got %s", lines[0])
+	}
+	if !strings.Contains(lines[1], "[ERROR]") {
+		t.Errorf("Expected error, got %s", lines[1])
+	}
+}
@@ -0,0 +1,141 @@
+//go:build slog_provider
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+	_ "github.com/LandcLi/landc-logface/providers/slog"
+)
+
+// TestSlogProvider 测试slog提供者
+func TestSlogProvider(t *testing.T) {
+	logger := lclogface.GetLoggerWithProvider("test", "slog", lclogface.WithLevel(lclogface.DebugLevel))
+	if logger == nil {
+		t.Fatal("创建slog日志失败")
+	}
+
+	logger.Info("slog日志测试")
+	logger.Debug("slog调试日志")
+	logger.WithField("slog", "test").Warn("slog警告日志")
+}
+
+// TestSlogJSON 测试slog的json格式、类型化字段、名称和上下文字段
+func TestSlogJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slog.log")
+	logger := lclogface.GetLoggerWithProvider("test-slog", "slog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+		lclogface.WithCaller(true),
+	)
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	expected := callerLine()
+	logger.Named("db").WithError(errors.New("boom")).InfoContext(ctx, "查询",
+		lclogface.Int("rows", 3),
+		lclogface.Bool("cached", true),
+	)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(readLines(t, path)[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]interface{}{
+		"level":    "INFO",
+		"msg":      "查询",
+		"logger":   "test-slog.db",
+		"caller":   expected,
+		"error":    "boom",
+		"trace_id": "trace-1",
+		"rows":     float64(3),
+		"cached":   true,
+	} {
+		if entry[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, entry[key])
+		}
+	}
+}
+
+// TestSlogLevels 测试slog的级别过滤、跟踪级别和自定义级别
+func TestSlogLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slog.log")
+	logger := lclogface.GetLoggerWithProvider("test-slog", "slog", lclogface.WithOutputPath(path))
+	logger.Debug("过滤")
+	logger.Log(noticeLevel, "自定义级别")
+	logger.SetLevel(lclogface.TraceLevel)
+	logger.Trace("跟踪")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], "level=NOTICE") || !strings.Contains(lines[1], "level=TRACE") {
+		t.Errorf("Expected facade level names, got %v", lines)
+	}
+}
+
+// TestSlogRotationAndMessageSize 测试slog通过lumberjack写入文件并限制消息大小
+func TestSlogRotationAndMessageSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "slog.log")
+	logger := lclogface.GetLoggerWithProvider("test-slog", "slog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithMaxLogSize(1),
+		lclogface.WithMaxMessageSize(1),
+	)
+	logger.Info(strings.Repeat("x", 2048))
+
+	line := readLines(t, path)[0]
+	if !strings.Contains(line, strings.Repeat("x", 1021)+"...") || strings.Contains(line, strings.Repeat("x", 1022)) {
+		t.Errorf("Expected message to be limited to 1KB, got %d bytes", len(line))
+	}
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Errorf("Expected file output to be closed, got %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected log file to exist, got %v", err)
+	}
+}
+
+// TestSlogStackTrace 测试slog文本格式以缩进块输出堆栈
+func TestSlogStackTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slog.log")
+	logger := lclogface.GetLoggerWithProvider("test-slog", "slog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithStackTrace(lclogface.ErrorLevel),
+	)
+	logger.Error("失败")
+
+	lines := readLines(t, path)
+	if len(lines) < 3 || strings.Contains(lines[0], lclogface.StackKey) {
+		t.Fatalf("Expected stack block below the entry, got %v", lines)
+	}
+	if lines[1] != "\tgithub.com/LandcLi/landc-logface/tests.TestSlogStackTrace" {
+		t.Errorf("Expected stack to start at the test function, got %q", lines[1])
+	}
+}
+
+// TestSlogOutputs 测试slog同时写入多个输出
+func TestSlogOutputs(t *testing.T) {
+	testProviderOutputs(t, "slog")
+}
+
+// TestSlogAsync 测试slog异步写入时Sync等待队列中的日志全部写入
+func TestSlogAsync(t *testing.T) {
+	testProviderAsync(t, "slog")
+}
+
+// TestSlogClose 测试slog关闭后写完缓冲的日志并丢弃之后的日志
+func TestSlogClose(t *testing.T) {
+	testProviderClose(t, "slog")
+}
+
+// TestSlogFatal 测试slog在Fatal退出程序前刷新输出
+func TestSlogFatal(t *testing.T) {
+	testProviderFatal(t, "slog")
+}
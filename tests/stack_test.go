@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+)
+
+// TestStackTrace 测试为不低于指定级别的日志附加堆栈
+func TestStackTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stack.log")
+	logger := lclogface.GetLoggerWithProvider("stack", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithStackTrace(lclogface.ErrorLevel),
+	)
+	logger.Warn("没有堆栈")
+	logger.Error("附加堆栈")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	warn, rest, _ := strings.Cut(output, "\n")
+	if !strings.HasSuffix(warn, "没有堆栈") {
+		t.Errorf("Expected no stack after warn, got %s", output)
+	}
+	lines := strings.Split(strings.TrimSpace(rest), "\n")
+	if !strings.HasSuffix(lines[0], "附加堆栈") || len(lines) < 3 {
+		t.Fatalf("Expected stack block after error, got %s", rest)
+	}
+	if !strings.HasPrefix(lines[1], "\t") || !strings.HasSuffix(lines[1], "tests.TestStackTrace") {
+		t.Errorf("Expected stack to start at the test function, got %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "\t\t") || !strings.Contains(lines[2], "stack_test.go:") {
+		t.Errorf("Expected indented file and line, got %q", lines[2])
+	}
+}
+
+// TestWithStack 测试单次调用附加堆栈
+func TestWithStack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stack.log")
+	logger := lclogface.GetLoggerWithProvider("stack", "console",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("json"),
+	)
+	logger.WithStack().WithField("k", "v").Info("单次堆栈")
+	logger.Info("没有堆栈")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	stack, _ := entry[lclogface.StackKey].(string)
+	if first, _, _ := strings.Cut(stack, "\n"); !strings.HasSuffix(first, "tests.TestWithStack") {
+		t.Errorf("Expected stack to start at the test function, got %q", stack)
+	}
+	if strings.Contains(lines[1], lclogface.StackKey) {
+		t.Errorf("Expected no stack without WithStack, got %s", lines[1])
+	}
+}
+
+// TestStackLevelConfig 测试从配置文件和配置map设置堆栈级别
+func TestStackLevelConfig(t *testing.T) {
+	config, err := lclogface.LoadConfig(strings.NewReader("stackLevel: warn\n"), "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.StackLevel == nil || *config.StackLevel != lclogface.WarnLevel {
+		t.Errorf("Expected stackLevel warn, got %v", config.StackLevel)
+	}
+	if lclogface.NewLogConfig().StackLevel != nil {
+		t.Error("Expected no stack level by default")
+	}
+
+	_, err = lclogface.BuildLoggerWithMap("stack", map[string]interface{}{"stackLevel": "error"})
+	if err != nil {
+		t.Errorf("Expected stackLevel to be accepted, got %v", err)
+	}
+	_, err = lclogface.BuildLoggerWithMap("stack", map[string]interface{}{"stackLevel": "loud"})
+	if err == nil {
+		t.Error("Expected error for unknown stackLevel")
+	}
+}
@@ -3,7 +3,15 @@
 package tests
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/LandcLi/landc-logface/lclogface"
 	_ "github.com/LandcLi/landc-logface/providers/zap"
@@ -39,3 +47,256 @@ func TestZapWithOptions(t *testing.T) {
 
 	logger.Info("Zap带选项测试")
 }
+
+// TestZapSetLevel 测试修改级别对zap底层和派生实例生效
+func TestZapSetLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap.log")
+	logger := lclogface.GetLoggerWithProvider("test-level", "zap",
+		lclogface.WithLevel(lclogface.ErrorLevel),
+		lclogface.WithOutputPath(path),
+	)
+	child := logger.WithField("child", true)
+
+	child.Debug("before")
+	logger.SetLevel(lclogface.DebugLevel)
+	child.Debug("after")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "before") || !strings.Contains(string(data), "after") {
+		t.Errorf("Expected only the message after SetLevel, got %s", data)
+	}
+}
+
+// TestZapNamed 测试zap输出层级名称
+func TestZapNamed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-named.log")
+	logger := lclogface.GetLoggerWithProvider("test-named", "zap", lclogface.WithOutputPath(path))
+	logger.Named("db").Info("named")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"logger":"test-named.db"`) {
+		t.Errorf("Expected logger name in output, got %s", data)
+	}
+}
+
+// TestZapTraceAndCustomLevel 测试zap输出跟踪级别和自定义级别
+func TestZapTraceAndCustomLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-levels.log")
+	logger := lclogface.GetLoggerWithProvider("test-levels", "zap",
+		lclogface.WithOutputPath(path), lclogface.WithLevel(lclogface.TraceLevel))
+	logger.Trace("trace message")
+	logger.Log(noticeLevel, "notice message")
+	logger.SetLevel(lclogface.WarnLevel)
+	logger.Log(noticeLevel, "hidden notice")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	if !strings.Contains(output, `"level":"trace"`) {
+		t.Errorf("Expected trace level in output, got %s", output)
+	}
+	if !strings.Contains(output, `"level":"notice"`) {
+		t.Errorf("Expected notice level in output, got %s", output)
+	}
+	if strings.Contains(output, "hidden notice") {
+		t.Errorf("Expected notice to be filtered at warn level, got %s", output)
+	}
+}
+
+// TestZapTypedFields 测试zap输出类型化字段
+func TestZapTypedFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-fields.log")
+	logger := lclogface.GetLoggerWithProvider("test-fields", "zap", lclogface.WithOutputPath(path))
+	logger.Info("typed",
+		lclogface.String("s", "v"),
+		lclogface.Int64("count", 3),
+		lclogface.Bool("ok", true),
+		lclogface.Duration("elapsed", 1500*time.Millisecond),
+		lclogface.Err(errors.New("boom")),
+		lclogface.Object("user", testUser{name: "alice", age: 30}),
+		lclogface.Array("tags", testTags{"a", "b"}),
+	)
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+	for _, expected := range []string{`"s":"v"`, `"count":3`, `"ok":true`, `"elapsed":1.5`, `"error":"boom"`, `"user":{"name":"alice","age":30}`, `"tags":["a","b"]`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %s in output, got %s", expected, output)
+		}
+	}
+}
+
+// TestZapContextFields 测试zap输出从上下文提取的字段
+func TestZapContextFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-context.log")
+	logger := lclogface.GetLoggerWithProvider("test-context", "zap", lclogface.WithOutputPath(path))
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	logger.InfoContext(ctx, "context")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"trace_id":"trace-1"`) {
+		t.Errorf("Expected trace_id in output, got %s", data)
+	}
+}
+
+// TestZapCaller 测试zap输出应用代码的调用位置而不是适配器内部
+func TestZapCaller(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-caller.log")
+	logger := lclogface.GetLoggerWithProvider("test-caller", "zap",
+		lclogface.WithOutputPath(path),
+		lclogface.WithCaller(true),
+	)
+	expected := callerLine()
+	logger.Info("caller")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"caller":"`+expected+`"`) || !strings.Contains(string(data), `tests.TestZapCaller"`) {
+		t.Errorf("Expected caller %s in output, got %s", expected, data)
+	}
+}
+
+// TestZapStackTrace 测试zap按级别和WithStack附加堆栈
+func TestZapStackTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-stack.log")
+	logger := lclogface.GetLoggerWithProvider("test-stack", "zap",
+		lclogface.WithOutputPath(path),
+		lclogface.WithStackTrace(lclogface.ErrorLevel),
+	)
+	logger.Warn("warn")
+	logger.Error("error")
+	logger.WithStack().Info("info")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %s", len(lines), data)
+	}
+	if strings.Contains(lines[0], `"stacktrace"`) {
+		t.Errorf("Expected no stack on warn, got %s", lines[0])
+	}
+	for _, line := range lines[1:] {
+		if !strings.Contains(line, `"stacktrace":"github.com/LandcLi/landc-logface/tests.TestZapStackTrace\n`) {
+			t.Errorf("Expected stack starting at the test function, got %s", line)
+		}
+	}
+}
+
+// TestZapErrorFields 测试zap展开错误字段
+func TestZapErrorFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-error.log")
+	logger := lclogface.GetLoggerWithProvider("test-error", "zap", lclogface.WithOutputPath(path))
+	err := fmt.Errorf("load: %w", &queryError{sql: "SELECT 1", err: errors.New("timeout")})
+	logger.WithError(err).Error("failed")
+	logger.Sync()
+
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	for _, expected := range []string{
+		`"error":"load: query failed: timeout"`,
+		`"error_type":"*fmt.wrapError"`,
+		`"error_causes":["query failed: timeout","timeout"]`,
+		`"sql":"SELECT 1"`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected %s in output, got %s", expected, data)
+		}
+	}
+}
+
+// TestZapSlogHandler 测试slog通过zap输出嵌套分组
+func TestZapSlogHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zap-slog.log")
+	logger := lclogface.GetLoggerWithProvider("test-slog", "zap", lclogface.WithOutputPath(path))
+	log := slog.New(lclogface.NewSlogHandler(logger))
+	log.WithGroup("req").Info("slog", "id", "r-1", "elapsed", 1500*time.Millisecond)
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"req":{"id":"r-1","elapsed":1.5}`) {
+		t.Errorf("Expected nested group in output, got %s", data)
+	}
+}
+
+// TestZapOutputs 测试zap同时写入多个输出
+func TestZapOutputs(t *testing.T) {
+	testProviderOutputs(t, "zap")
+}
+
+// TestZapAsync 测试zap异步写入时Sync等待队列中的日志全部写入
+func TestZapAsync(t *testing.T) {
+	testProviderAsync(t, "zap")
+}
+
+// TestZapClose 测试zap关闭后写完缓冲的日志并丢弃之后的日志
+func TestZapClose(t *testing.T) {
+	testProviderClose(t, "zap")
+}
+
+// TestZapFatal 测试zap在Fatal退出程序前刷新输出
+func TestZapFatal(t *testing.T) {
+	testProviderFatal(t, "zap")
+}
+
+// reconfigureOnString 编码时在另一个goroutine中重新配置日志实例，等到重新配置开始等待写锁后再返回
+type reconfigureOnString struct {
+	log  lclogface.Logger
+	path string
+}
+
+// String 实现fmt.Stringer
+func (r reconfigureOnString) String() string {
+	go r.log.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath(r.path))
+	time.Sleep(100 * time.Millisecond)
+	return "reconfiguring"
+}
+
+// TestZapFatalDuringReconfigure 测试Fatal写入日志时有重新配置在等待，退出前的刷新不会与它互相等待
+func TestZapFatalDuringReconfigure(t *testing.T) {
+	if path := os.Getenv(subprocessEnv); path != "" {
+		log := lclogface.GetLoggerWithProvider("fatal-reconfigure", "zap", lclogface.WithOutputPath(path))
+		time.AfterFunc(3*time.Second, func() { os.Exit(2) })
+		log.Fatal("fatal", lclogface.Any("state", reconfigureOnString{log: log, path: path}))
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "fatal.log")
+	code, output := runSubprocess(t, path)
+	if code != 1 {
+		t.Fatalf("Expected Fatal to exit with code 1, got %d:\n%s", code, output)
+	}
+	if lines := readLines(t, path); len(lines) != 1 || !strings.Contains(lines[0], "fatal") {
+		t.Errorf("Expected the fatal entry to be written, got %v", lines)
+	}
+}
@@ -0,0 +1,179 @@
+//go:build zerolog_provider
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/LandcLi/landc-logface/lclogface"
+	_ "github.com/LandcLi/landc-logface/providers/zerolog"
+)
+
+// TestZerologProvider 测试zerolog提供者
+func TestZerologProvider(t *testing.T) {
+	logger := lclogface.GetLoggerWithProvider("test", "zerolog", lclogface.WithLevel(lclogface.DebugLevel))
+	if logger == nil {
+		t.Fatal("创建zerolog日志失败")
+	}
+
+	logger.Info("zerolog日志测试")
+	logger.Debug("zerolog调试日志")
+	logger.WithField("zerolog", "test").Warn("zerolog警告日志")
+}
+
+// TestZerologJSON 测试zerolog默认的json格式、类型化字段、名称和上下文字段
+func TestZerologJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zerolog.log")
+	logger := lclogface.GetLoggerWithProvider("test-zerolog", "zerolog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithCaller(true),
+	)
+	ctx := lclogface.ContextWithTraceID(context.Background(), "trace-1")
+	expected := callerLine()
+	logger.Named("db").WithError(errors.New("boom")).InfoContext(ctx, "查询",
+		lclogface.Int("rows", 3),
+		lclogface.Bool("cached", true),
+		lclogface.Err(&queryError{sql: "SELECT 1", err: errors.New("timeout")}),
+	)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(readLines(t, path)[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range map[string]interface{}{
+		"level":    "info",
+		"message":  "查询",
+		"logger":   "test-zerolog.db",
+		"caller":   expected,
+		"error":    "query failed: timeout",
+		"trace_id": "trace-1",
+		"rows":     float64(3),
+		"cached":   true,
+		"sql":      "SELECT 1",
+	} {
+		if entry[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, entry[key])
+		}
+	}
+	if !reflect.DeepEqual(entry["error_causes"], []interface{}{"timeout"}) {
+		t.Errorf("Expected error causes, got %v", entry["error_causes"])
+	}
+}
+
+// TestZerologLevels 测试zerolog的级别过滤、跟踪级别和自定义级别
+func TestZerologLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zerolog.log")
+	logger := lclogface.GetLoggerWithProvider("test-zerolog", "zerolog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("text"),
+	)
+	logger.Debug("过滤")
+	logger.Log(noticeLevel, "自定义级别")
+	logger.SetLevel(lclogface.TraceLevel)
+	logger.Trace("跟踪")
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], " NOTICE 自定义级别") || !strings.Contains(lines[1], " TRACE 跟踪") {
+		t.Errorf("Expected facade level names, got %v", lines)
+	}
+}
+
+// TestZerologNativeLevels 测试内置级别以zerolog的原生级别输出并受zerolog全局级别的过滤，跟踪级别和自定义级别不受影响
+func TestZerologNativeLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zerolog.log")
+	logger := lclogface.GetLoggerWithProvider("test-zerolog-native", "zerolog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithLevel(lclogface.TraceLevel),
+	)
+	previous := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	defer zerolog.SetGlobalLevel(previous)
+
+	logger.Info("过滤")
+	logger.Warn("警告")
+	logger.Trace("跟踪")
+	logger.Log(noticeLevel, "自定义级别")
+
+	lines := readLines(t, path)
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %v", lines)
+	}
+	for i, expected := range []string{"warn", "trace", "notice"} {
+		if strings.Count(lines[i], `"level"`) != 1 || !strings.Contains(lines[i], `"level":"`+expected+`"`) {
+			t.Errorf("Expected a single %s level field, got %s", expected, lines[i])
+		}
+	}
+}
+
+// TestZerologRotationAndMessageSize 测试zerolog通过lumberjack写入文件并限制消息大小
+func TestZerologRotationAndMessageSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "zerolog.log")
+	logger := lclogface.GetLoggerWithProvider("test-zerolog", "zerolog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithMaxLogSize(1),
+		lclogface.WithMaxMessageSize(1),
+	)
+	logger.Info(strings.Repeat("x", 2048))
+
+	line := readLines(t, path)[0]
+	if !strings.Contains(line, strings.Repeat("x", 1021)+"...") || strings.Contains(line, strings.Repeat("x", 1022)) {
+		t.Errorf("Expected message to be limited to 1KB, got %d bytes", len(line))
+	}
+	if err := logger.(lclogface.Reconfigurable).Reconfigure(lclogface.WithOutputPath("stdout")); err != nil {
+		t.Errorf("Expected file output to be closed, got %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected log file to exist, got %v", err)
+	}
+}
+
+// TestZerologStackTrace 测试zerolog文本格式以缩进块输出堆栈
+func TestZerologStackTrace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "zerolog.log")
+	logger := lclogface.GetLoggerWithProvider("test-zerolog", "zerolog",
+		lclogface.WithOutputPath(path),
+		lclogface.WithFormat("text"),
+		lclogface.WithStackTrace(lclogface.ErrorLevel),
+	)
+	logger.Error("失败")
+
+	lines := readLines(t, path)
+	if len(lines) < 3 || strings.Contains(lines[0], lclogface.StackKey) {
+		t.Fatalf("Expected stack block below the entry, got %v", lines)
+	}
+	if lines[1] != "\tgithub.com/LandcLi/landc-logface/tests.TestZerologStackTrace" {
+		t.Errorf("Expected stack to start at the test function, got %q", lines[1])
+	}
+}
+
+// TestZerologOutputs 测试zerolog同时写入多个输出
+func TestZerologOutputs(t *testing.T) {
+	testProviderOutputs(t, "zerolog")
+}
+
+// TestZerologAsync 测试zerolog异步写入时Sync等待队列中的日志全部写入
+func TestZerologAsync(t *testing.T) {
+	testProviderAsync(t, "zerolog")
+}
+
+// TestZerologClose 测试zerolog关闭后写完缓冲的日志并丢弃之后的日志
+func TestZerologClose(t *testing.T) {
+	testProviderClose(t, "zerolog")
+}
+
+// TestZerologFatal 测试zerolog在Fatal退出程序前刷新输出
+func TestZerologFatal(t *testing.T) {
+	testProviderFatal(t, "zerolog")
+}